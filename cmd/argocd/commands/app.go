@@ -22,6 +22,7 @@ import (
 	"github.com/yudai/gojsondiff"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -1914,38 +1915,256 @@ func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	return &command
 }
 
-func filterResources(command *cobra.Command, resources []*argoappv1.ResourceDiff, group, kind, namespace, resourceName string, all bool) []*unstructured.Unstructured {
+// kindPlurals maps the plural form of commonly used kinds to their singular kind, so that kinds can be
+// specified in the same way as with kubectl (e.g. --kind deployments)
+var kindPlurals = map[string]string{
+	"applications":             "Application",
+	"configmaps":               "ConfigMap",
+	"cronjobs":                 "CronJob",
+	"daemonsets":               "DaemonSet",
+	"deployments":              "Deployment",
+	"horizontalpodautoscalers": "HorizontalPodAutoscaler",
+	"ingresses":                "Ingress",
+	"jobs":                     "Job",
+	"namespaces":               "Namespace",
+	"persistentvolumeclaims":   "PersistentVolumeClaim",
+	"pods":                     "Pod",
+	"replicasets":              "ReplicaSet",
+	"rollouts":                 "Rollout",
+	"secrets":                  "Secret",
+	"serviceaccounts":          "ServiceAccount",
+	"services":                 "Service",
+	"statefulsets":             "StatefulSet",
+}
+
+// normalizeKind returns the singular kind for a plural kind, or the kind unchanged if it is not a known plural
+func normalizeKind(kind string) string {
+	if singular, ok := kindPlurals[strings.ToLower(kind)]; ok {
+		return singular
+	}
+	return kind
+}
+
+// parseKinds splits comma separated kinds, e.g. "deployments,StatefulSet", and normalizes each of them. No kinds are
+// returned for an empty string.
+func parseKinds(kinds string) []string {
+	var parsed []string
+	for _, kind := range strings.Split(kinds, ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			parsed = append(parsed, normalizeKind(kind))
+		}
+	}
+	return parsed
+}
+
+const (
+	// scopeCluster is the scope of resources which do not belong to a namespace
+	scopeCluster = "Cluster"
+	// scopeNamespaced is the scope of resources which belong to a namespace
+	scopeNamespaced = "Namespaced"
+)
+
+// clusterScopedKinds are the well known kinds whose resources do not belong to a namespace
+var clusterScopedKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "Namespace"}:                                                  true,
+	{Group: "", Kind: "Node"}:                                                       true,
+	{Group: "", Kind: "PersistentVolume"}:                                           true,
+	{Group: "", Kind: "ComponentStatus"}:                                            true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:                       true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}:                true,
+	{Group: "storage.k8s.io", Kind: "StorageClass"}:                                 true,
+	{Group: "storage.k8s.io", Kind: "VolumeAttachment"}:                             true,
+	{Group: "storage.k8s.io", Kind: "CSIDriver"}:                                    true,
+	{Group: "storage.k8s.io", Kind: "CSINode"}:                                      true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}:               true,
+	{Group: "apiregistration.k8s.io", Kind: "APIService"}:                           true,
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}:   true,
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}: true,
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"}:                             true,
+	{Group: "policy", Kind: "PodSecurityPolicy"}:                                    true,
+	{Group: "extensions", Kind: "PodSecurityPolicy"}:                                true,
+	{Group: "node.k8s.io", Kind: "RuntimeClass"}:                                    true,
+	{Group: "certificates.k8s.io", Kind: "CertificateSigningRequest"}:               true,
+}
+
+// resourceScope returns the scope of a resource of the group and kind in the namespace, i.e. scopeCluster or
+// scopeNamespaced. Resources of kinds other than clusterScopedKinds, e.g. custom resources, are cluster-scoped if they
+// have no namespace, since the controller clears the namespace of the resources whose kind the API discovery of the
+// cluster reports as cluster-scoped.
+func resourceScope(gk schema.GroupKind, namespace string) string {
+	if clusterScopedKinds[gk] || namespace == "" {
+		return scopeCluster
+	}
+	return scopeNamespaced
+}
+
+// resourceMismatch returns the reason why the live object does not match the given selectors, or an empty string
+// if it matches. Any of the kinds match, and all kinds match if none are given. Cluster-scoped objects match any
+// namespace.
+func resourceMismatch(obj *unstructured.Unstructured, filterGroup bool, group string, kinds []string, namespace, resourceName string, selector labels.Selector) string {
+	if obj == nil {
+		return "resource does not exist in the cluster"
+	}
+	gvk := obj.GroupVersionKind()
+	if filterGroup && group != gvk.Group {
+		return fmt.Sprintf("group '%s' does not match '%s'", gvk.Group, group)
+	}
+	if namespace != "" && namespace != obj.GetNamespace() && resourceScope(gvk.GroupKind(), obj.GetNamespace()) == scopeNamespaced {
+		return fmt.Sprintf("namespace '%s' does not match '%s'", obj.GetNamespace(), namespace)
+	}
+	if resourceName != "" && resourceName != obj.GetName() {
+		return fmt.Sprintf("name '%s' does not match '%s'", obj.GetName(), resourceName)
+	}
+	if len(kinds) > 0 && !containsString(kinds, gvk.Kind) {
+		return fmt.Sprintf("kind '%s' does not match '%s'", gvk.Kind, strings.Join(kinds, ","))
+	}
+	if !selector.Matches(labels.Set(obj.GetLabels())) {
+		return fmt.Sprintf("labels do not match selector '%s'", selector.String())
+	}
+	return ""
+}
+
+// explainResourceSelection prints whether each of the resources is selected by filterResources and, if not, why
+func explainResourceSelection(w io.Writer, command *cobra.Command, resources []*argoappv1.ResourceDiff, group string, kinds []string, namespace, resourceName string, selector labels.Selector) {
 	liveObjs, err := liveObjects(resources)
 	errors.CheckError(err)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "GROUP\tKIND\tNAMESPACE\tNAME\tSELECTED\tREASON\n")
+	for i, res := range resources {
+		reason := resourceMismatch(liveObjs[i], command.Flags().Changed("group"), group, kinds, namespace, resourceName, selector)
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", res.Group, res.Kind, res.Namespace, res.Name, strconv.FormatBool(reason == ""), reason)
+	}
+	_ = tw.Flush()
+}
+
+// ResourceFilter holds the criteria by which FilterResources selects resources. Empty criteria match all resources.
+type ResourceFilter struct {
+	// MatchGroup is whether resources are matched by Group. Since the core group is empty, an empty Group only
+	// selects resources of the core group if MatchGroup is set.
+	MatchGroup bool
+	Group      string
+	// Kinds select resources of any of the kinds, which may be singular or plural, e.g. Deployment or deployments
+	Kinds     []string
+	Namespace string
+	Name      string
+	// Selector selects resources by their labels. A nil selector matches all resources.
+	Selector labels.Selector
+	// All allows more than one resource to match. Unless set, matching multiple resources is an error.
+	All bool
+}
+
+// multipleMatchError indicates that several resources matched the criteria although only one was expected
+type multipleMatchError struct {
+	error
+	// namespaces are the sorted namespaces of the matching resources
+	namespaces []string
+}
+
+// FilterResources returns copies of the live objects of the resources matching the filter. Resources which do not
+// exist in the cluster never match. A noMatchError is returned if no resource matches, and a multipleMatchError if
+// several resources match unless the filter allows all of them.
+func FilterResources(resources []*argoappv1.ResourceDiff, filter ResourceFilter) ([]*unstructured.Unstructured, error) {
+	liveObjs, err := liveObjects(resources)
+	if err != nil {
+		return nil, err
+	}
+	selector := filter.Selector
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	var kinds []string
+	for _, kind := range filter.Kinds {
+		kinds = append(kinds, normalizeKind(kind))
+	}
 	filteredObjects := make([]*unstructured.Unstructured, 0)
-	for i := range liveObjs {
-		obj := liveObjs[i]
-		if obj == nil {
+	for _, obj := range liveObjs {
+		if resourceMismatch(obj, filter.MatchGroup, filter.Group, kinds, filter.Namespace, filter.Name, selector) != "" {
 			continue
 		}
-		gvk := obj.GroupVersionKind()
-		if command.Flags().Changed("group") && group != gvk.Group {
-			continue
+		filteredObjects = append(filteredObjects, obj.DeepCopy())
+	}
+	if len(filteredObjects) == 0 {
+		return nil, noMatchError{fmt.Errorf("No matching resource found")}
+	}
+	if len(filteredObjects) > 1 && !filter.All {
+		namespaces := make(map[string]bool)
+		for _, obj := range filteredObjects {
+			namespaces[obj.GetNamespace()] = true
 		}
-		if namespace != "" && namespace != obj.GetNamespace() {
-			continue
+		var names []string
+		for ns := range namespaces {
+			names = append(names, ns)
 		}
-		if resourceName != "" && resourceName != obj.GetName() {
-			continue
+		sort.Strings(names)
+		return nil, multipleMatchError{fmt.Errorf("Multiple resources match inputs. Use the --all flag to patch multiple resources"), names}
+	}
+	return filteredObjects, nil
+}
+
+// filterResources returns the live objects of the resources matching the selector flags of the command, exiting if
+// none or, unless all is set, more than one match
+func filterResources(command *cobra.Command, resources []*argoappv1.ResourceDiff, group string, kinds []string, namespace, resourceName string, selector labels.Selector, all bool) []*unstructured.Unstructured {
+	filteredObjects, err := FilterResources(resources, ResourceFilter{
+		MatchGroup: command.Flags().Changed("group"),
+		Group:      group,
+		Kinds:      kinds,
+		Namespace:  namespace,
+		Name:       resourceName,
+		Selector:   selector,
+		All:        all,
+	})
+	switch err := err.(type) {
+	case nil:
+	case noMatchError:
+		if out := command.Flags().Lookup("out"); len(resources) > 0 && (out == nil || out.Value.String() != "json") {
+			liveObjs, err := liveObjects(resources)
+			errors.CheckError(err)
+			log.Infof("Kinds present in the application: %s", presentKinds(liveObjs, maxPresentKinds))
 		}
-		if kind != "" && kind != gvk.Kind {
-			continue
+		fatalWithCode(exitCodeNoMatch, "%v", err)
+	case multipleMatchError:
+		if len(err.namespaces) > 1 {
+			log.Warnf("Resources matching inputs were found in multiple namespaces (%s). Use the --namespace flag to select a single namespace", strings.Join(err.namespaces, ", "))
 		}
-		copy := obj.DeepCopy()
-		filteredObjects = append(filteredObjects, copy)
+		fatalWithCode(exitCodeInvalidArgs, "%v", err)
+	default:
+		errors.CheckError(err)
 	}
-	if len(filteredObjects) == 0 {
-		log.Fatal("No matching resource found")
+	return filteredObjects
+}
+
+// maxPresentKinds is the number of kinds listed in the hint printed when no resource matches
+const maxPresentKinds = 5
+
+// presentKinds returns the most common kinds of the given objects along with their number, e.g.
+// "Deployment (3), Service (2) and 4 more"
+func presentKinds(objs []*unstructured.Unstructured, max int) string {
+	counts := make(map[string]int)
+	for _, obj := range objs {
+		counts[obj.GetKind()]++
 	}
-	if len(filteredObjects) > 1 && !all {
-		log.Fatal("Multiple resources match inputs. Use the --all flag to patch multiple resources")
+	var kinds []string
+	for kind := range counts {
+		kinds = append(kinds, kind)
 	}
-	return filteredObjects
+	sort.Slice(kinds, func(i, j int) bool {
+		if counts[kinds[i]] != counts[kinds[j]] {
+			return counts[kinds[i]] > counts[kinds[j]]
+		}
+		return kinds[i] < kinds[j]
+	})
+	var items []string
+	for i, kind := range kinds {
+		if i == max {
+			break
+		}
+		items = append(items, fmt.Sprintf("%s (%d)", kind, counts[kind]))
+	}
+	hint := strings.Join(items, ", ")
+	if len(kinds) > max {
+		hint += fmt.Sprintf(" and %d more", len(kinds)-max)
+	}
+	return hint
 }
 
 func NewApplicationPatchResourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
@@ -1966,11 +2185,11 @@ func NewApplicationPatchResourceCommand(clientOpts *argocdclient.ClientOptions)
 	errors.CheckError(err)
 	command.Flags().StringVar(&patchType, "patch-type", string(types.MergePatchType), "Which Patching strategy to use: 'application/json-patch+json', 'application/merge-patch+json', or 'application/strategic-merge-patch+json'. Defaults to 'application/merge-patch+json'")
 	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
-	command.Flags().StringVar(&kind, "kind", "", "Kind")
+	command.Flags().StringVar(&kind, "kind", "", "Kind (singular or plural, e.g. Deployment or deployments). Several kinds may be given comma separated, e.g. Deployment,StatefulSet")
 	err = command.MarkFlagRequired("kind")
 	errors.CheckError(err)
 	command.Flags().StringVar(&group, "group", "", "Group")
-	command.Flags().StringVar(&namespace, "namespace", "", "Namespace")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace. Cluster-scoped resources match any namespace")
 	command.Flags().BoolVar(&all, "all", false, "Indicates whether to patch multiple matching of resources")
 	command.Run = func(c *cobra.Command, args []string) {
 		if len(args) != 1 {
@@ -1984,7 +2203,7 @@ func NewApplicationPatchResourceCommand(clientOpts *argocdclient.ClientOptions)
 		ctx := context.Background()
 		resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
 		errors.CheckError(err)
-		objectsToPatch := filterResources(command, resources.Items, group, kind, namespace, resourceName, all)
+		objectsToPatch := filterResources(command, resources.Items, group, parseKinds(kind), namespace, resourceName, labels.Everything(), all)
 		for i := range objectsToPatch {
 			obj := objectsToPatch[i]
 			gvk := obj.GroupVersionKind()
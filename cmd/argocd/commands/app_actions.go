@@ -2,27 +2,59 @@ package commands
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"log"
+	"io"
+	"io/ioutil"
 	"os"
-	"sort"
-	"strconv"
 	"strings"
-	"text/tabwriter"
+	"time"
 
-	"github.com/ghodss/yaml"
+	"github.com/Masterminds/semver"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
-	"github.com/argoproj/argo-cd/errors"
+	"github.com/argoproj/argo-cd/common"
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
 	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
-	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/cli"
 )
 
+// teeOutput returns a writer which writes to w and, unless path is empty, also to the file at path, along with a
+// function closing the file. The file is not buffered, so it holds all output written so far even if the command
+// exits early.
+func teeOutput(w io.Writer, path string) (io.Writer, func(), error) {
+	if path == "" {
+		return w, func() {}, nil
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return io.MultiWriter(w, file), func() { _ = file.Close() }, nil
+}
+
+// diagnosticOutput is where the action commands write progress, warnings and summaries, which are neither errors nor
+// the requested data. It is discarded with --quiet.
+var diagnosticOutput io.Writer = os.Stderr
+
+// quietOutput is whether --quiet is set, in which case the calls are not logged even with --verbose
+var quietOutput bool
+
+// grpcCompressionNone is the value of the --grpc-compression flag disabling compression
+const grpcCompressionNone = "none"
+
 // NewApplicationResourceActionsCommand returns a new instance of an `argocd app actions` command
 func NewApplicationResourceActionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var keepAliveTime time.Duration
+	var keepAliveTimeout time.Duration
+	var grpcCompression string
+	var quiet bool
 	var command = &cobra.Command{
 		Use:   "actions",
 		Short: "Manage Resource actions",
@@ -30,164 +62,248 @@ func NewApplicationResourceActionsCommand(clientOpts *argocdclient.ClientOptions
 			c.HelpFunc()(c, args)
 			os.Exit(1)
 		},
+		PersistentPreRun: func(c *cobra.Command, args []string) {
+			// only results are printed to stdout, so that redirecting them never captures prompts
+			cli.PromptOutput = os.Stderr
+			if quiet {
+				log.SetLevel(log.ErrorLevel)
+				diagnosticOutput = ioutil.Discard
+				quietOutput = true
+			}
+			if keepAliveTime != 0 && keepAliveTime < common.GRPCKeepAliveEnforcementMinimum {
+				fatalWithCode(exitCodeInvalidArgs, "--keepalive-time must be 0 or at least %s", common.GRPCKeepAliveEnforcementMinimum)
+			}
+			if keepAliveTimeout <= 0 {
+				fatalWithCode(exitCodeInvalidArgs, "--keepalive-timeout must be positive")
+			}
+			clientOpts.KeepAliveTime = keepAliveTime
+			clientOpts.KeepAliveTimeout = keepAliveTimeout
+			switch grpcCompression {
+			case grpcCompressionNone:
+			case argocdclient.CompressionGzip:
+				clientOpts.Compression = argocdclient.CompressionGzip
+			default:
+				fatalWithCode(exitCodeInvalidArgs, "Unsupported --grpc-compression value '%s'. One of: %s, %s", grpcCompression, grpcCompressionNone, argocdclient.CompressionGzip)
+			}
+		},
 	}
+	command.PersistentFlags().DurationVar(&keepAliveTime, "keepalive-time", common.DefaultGRPCKeepAliveTime, fmt.Sprintf("Interval at which keepalive pings are sent to the server while a call is in progress, to keep the connection from being dropped by proxies. Must be 0 (disabled) or at least %s", common.GRPCKeepAliveEnforcementMinimum))
+	command.PersistentFlags().DurationVar(&keepAliveTimeout, "keepalive-timeout", common.DefaultGRPCKeepAliveTimeout, "Time to wait for a keepalive ping to be acknowledged before the connection is considered broken")
+	command.PersistentFlags().StringVar(&grpcCompression, "grpc-compression", grpcCompressionNone, fmt.Sprintf("Compression of gRPC calls, reducing the size of large managed resource responses over slow links. One of: %s, %s. gzip requires argocd-server v1.3.0 or later", grpcCompressionNone, argocdclient.CompressionGzip))
+	command.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Only print the requested data to stdout and errors to stderr, leaving out progress, warnings and summaries. Also leaves out the calls logged with --verbose")
 	command.AddCommand(NewApplicationResourceActionsListCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsRunCommand(clientOpts))
+	command.AddCommand(NewApplicationResourceActionsReplayCommand(clientOpts))
+	command.AddCommand(NewApplicationResourceActionsExportCommand(clientOpts))
+	command.AddCommand(NewApplicationResourceActionsHistoryCommand(clientOpts))
+	command.AddCommand(NewApplicationResourceActionsUndoCommand(clientOpts))
 	return command
 }
 
-// NewApplicationResourceActionsListCommand returns a new instance of an `argocd app actions list` command
-func NewApplicationResourceActionsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var namespace string
-	var kind string
-	var group string
-	var resourceName string
-	var output string
-	var command = &cobra.Command{
-		Use:   "list APPNAME",
-		Short: "Lists available actions on a resource",
-	}
-	command.Run = func(c *cobra.Command, args []string) {
-		if len(args) != 1 {
-			c.HelpFunc()(c, args)
-			os.Exit(1)
-		}
-		appName := args[0]
-		conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
-		defer util.Close(conn)
-		ctx := context.Background()
-		resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
-		errors.CheckError(err)
-		filteredObjects := filterResources(command, resources.Items, group, kind, namespace, resourceName, true)
-		availableActions := make(map[string][]argoappv1.ResourceAction)
-		for i := range filteredObjects {
-			obj := filteredObjects[i]
-			gvk := obj.GroupVersionKind()
-			availActionsForResource, err := appIf.ListResourceActions(ctx, &applicationpkg.ApplicationResourceRequest{
-				Name:         &appName,
-				Namespace:    obj.GetNamespace(),
-				ResourceName: obj.GetName(),
-				Group:        gvk.Group,
-				Kind:         gvk.Kind,
-			})
-			errors.CheckError(err)
-			availableActions[gvk.Group+"\t"+gvk.Kind+"\t"+obj.GetName()] = availActionsForResource.Actions
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
 		}
+	}
+	return false
+}
 
-		var keys []string
-		for key := range availableActions {
-			keys = append(keys, key)
+// actionErrorStackTrace returns the Lua stack trace the server attached to the error of a failed action, if any
+func actionErrorStackTrace(err error) []string {
+	var stackTrace []string
+	for _, detail := range status.Convert(err).Details() {
+		if debugInfo, ok := detail.(*errdetails.DebugInfo); ok {
+			stackTrace = append(stackTrace, debugInfo.StackEntries...)
 		}
-		sort.Strings(keys)
-
-		switch output {
-		case "yaml":
-			yamlBytes, err := yaml.Marshal(availableActions)
-			errors.CheckError(err)
-			fmt.Println(string(yamlBytes))
-		case "json":
-			jsonBytes, err := json.MarshalIndent(availableActions, "", "  ")
-			errors.CheckError(err)
-			fmt.Println(string(jsonBytes))
-		case "":
-			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintf(w, "GROUP\tKIND\tNAME\tACTION\tAVAILABLE\n")
-			fmt.Println()
-			for key := range availableActions {
-				for i := range availableActions[key] {
-					action := availableActions[key][i]
-					fmt.Fprintf(w, "%s\t%s\t%s\n", key, action.Name, strconv.FormatBool(action.Available))
-
-				}
+	}
+	return stackTrace
+}
+
+// formatStatusError returns the message of the error followed by the details the server attached to its status, if
+// any, each on a line of its own
+func formatStatusError(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err.Error()
+	}
+	lines := []string{err.Error()}
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *applicationpkg.ResourceActionFailure:
+			lines = append(lines, fmt.Sprintf("reason: %s", detail.Reason))
+		case *errdetails.DebugInfo:
+			if detail.Detail != "" {
+				lines = append(lines, fmt.Sprintf("debug info: %s", detail.Detail))
+			}
+			if len(detail.StackEntries) > 0 {
+				lines = append(lines, fmt.Sprintf("stack trace:\n\t\t%s", strings.Join(detail.StackEntries, "\n\t\t")))
 			}
-			_ = w.Flush()
+		case *errdetails.BadRequest:
+			for _, violation := range detail.FieldViolations {
+				lines = append(lines, fmt.Sprintf("invalid field %s: %s", violation.Field, violation.Description))
+			}
+		case *errdetails.PreconditionFailure:
+			for _, violation := range detail.Violations {
+				lines = append(lines, fmt.Sprintf("precondition failed: %s %s: %s", violation.Type, violation.Subject, violation.Description))
+			}
+		case *errdetails.ResourceInfo:
+			lines = append(lines, fmt.Sprintf("resource: %s %s: %s", detail.ResourceType, detail.ResourceName, detail.Description))
+		case *errdetails.LocalizedMessage:
+			lines = append(lines, fmt.Sprintf("message: %s", detail.Message))
+		case *errdetails.Help:
+			for _, link := range detail.Links {
+				lines = append(lines, fmt.Sprintf("help: %s %s", link.Description, link.Url))
+			}
+		case proto.Message:
+			lines = append(lines, fmt.Sprintf("%s: %s", proto.MessageName(detail), proto.CompactTextString(detail)))
+		case error:
+			// the detail is of a type unknown to the client
+			lines = append(lines, fmt.Sprintf("detail: %v", detail))
 		}
 	}
-	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
-	command.Flags().StringVar(&kind, "kind", "", "Kind")
-	command.Flags().StringVar(&group, "group", "", "Group")
-	command.Flags().StringVar(&namespace, "namespace", "", "Namespace")
-	command.Flags().StringVarP(&output, "out", "o", "", "Output format. One of: yaml, json")
-
-	return command
+	return strings.Join(lines, "\n\t")
 }
 
-// NewApplicationResourceActionsRunCommand returns a new instance of an `argocd app actions run` command
-func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var namespace string
-	var resourceName string
-	var kindArg string
-	var all bool
-	var command = &cobra.Command{
-		Use:   "run APPNAME ACTION",
-		Short: "Runs an available action on resource(s)",
+// checkStatusError exits with the message of the error along with the details attached to its status, if any
+func checkStatusError(err error) {
+	if err != nil {
+		log.Fatal(formatStatusError(err))
 	}
+}
+
+const (
+	// defaultActionCallTimeoutSeconds is the default timeout of each action call made to the server
+	defaultActionCallTimeoutSeconds = 60
+	// defaultManagedResourcesTimeoutSeconds is the default timeout of the call listing the managed resources of an
+	// application, which may take a while for applications with many resources
+	defaultManagedResourcesTimeoutSeconds = 120
+	// defaultObserveDurationSeconds is the default time to print the events of the affected resources for
+	defaultObserveDurationSeconds = 30
+	// defaultWatchTimeoutSeconds is the default time to wait for the affected resources to become healthy. Rollouts
+	// usually take much longer than the action calls which trigger them.
+	defaultWatchTimeoutSeconds = 600
+	// defaultAuditSinkTimeoutSeconds is the default timeout of each request posting a record to the audit sink
+	defaultAuditSinkTimeoutSeconds = 10
+)
 
-	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
-	command.Flags().StringVar(&namespace, "namespace", "", "Namespace")
-	command.Flags().StringVar(&kindArg, "kind", "", "Kind")
-	command.Flags().BoolVar(&all, "all", false, "Indicates whether to run the action on multiple matching resources")
+// Exit codes of the resource action commands
+const (
+	// exitCodeActionFailed indicates that an action failed or that another error occurred
+	exitCodeActionFailed = 1
+	// exitCodeNoMatch indicates that no resources matched the selectors
+	exitCodeNoMatch = 2
+	// exitCodeInvalidArgs indicates invalid arguments or flags
+	exitCodeInvalidArgs = 3
+	// exitCodePartialFailure indicates that an action succeeded on some resources but failed on others
+	exitCodePartialFailure = 4
+)
 
-	command.Run = func(c *cobra.Command, args []string) {
-		if len(args) != 2 {
-			c.HelpFunc()(c, args)
-			os.Exit(1)
+// noMatchError indicates that no resources matched the selectors
+type noMatchError struct {
+	error
+}
+
+// exit exits the process. It is replaced by tests of the exit codes
+var exit = os.Exit
+
+// fatalWithCode logs the formatted message and exits with the given code
+func fatalWithCode(code int, format string, args ...interface{}) {
+	log.Errorf(format, args...)
+	exit(code)
+}
+
+// flagRules are the combinations of flags of a command which are validated before the command does any work
+type flagRules struct {
+	// conflicts are pairs of flags which cannot be combined
+	conflicts [][2]string
+	// requires are lists of flags where the first flag has no effect without any of the others
+	requires [][]string
+}
+
+// flagSet returns whether the flag was explicitly set on the command line. Boolean flags explicitly set to false
+// are considered unset.
+func flagSet(command *cobra.Command, name string) bool {
+	flag := command.Flags().Lookup(name)
+	if flag == nil || !flag.Changed {
+		return false
+	}
+	return flag.Value.Type() != "bool" || flag.Value.String() == "true"
+}
+
+// validateFlags returns an error for the first combination of flags set on the command which violates the rules
+func validateFlags(command *cobra.Command, rules flagRules) error {
+	for _, pair := range rules.conflicts {
+		if flagSet(command, pair[0]) && flagSet(command, pair[1]) {
+			return fmt.Errorf("--%s cannot be combined with --%s. Please remove one of them", pair[0], pair[1])
 		}
-		appName := args[0]
-		actionName := args[1]
-
-		conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
-		defer util.Close(conn)
-		ctx := context.Background()
-		resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
-		errors.CheckError(err)
-
-		var group string
-		var kind string
-		var actionNameOnly string
-		// Backwards comparability for running resume actions
-		if actionName == "resume" && kindArg == "Rollout" {
-			group = "argoproj.io"
-			kind = "Rollout"
-			actionNameOnly = "resume"
-			commandTail := ""
-			if resourceName != "" {
-				commandTail += " --resource-name " + resourceName
-			}
-			if namespace != "" {
-				commandTail += " --namespace " + namespace
-			}
-			if all {
-				commandTail += " --all"
-			}
-			fmt.Printf("\nWarning: this syntax for running the \"resume\" action has been deprecated. Please run the action as\n\n\targocd app actions run %s argoproj.io/Rollout/resume%s\n\n", appName, commandTail)
-		} else {
-			group, kind, actionNameOnly = parseActionName(actionName)
+	}
+	for _, flags := range rules.requires {
+		if !flagSet(command, flags[0]) {
+			continue
 		}
-
-		filteredObjects := filterResources(command, resources.Items, group, kind, namespace, resourceName, all)
-		for i := range filteredObjects {
-			obj := filteredObjects[i]
-			gvk := obj.GroupVersionKind()
-			objResourceName := obj.GetName()
-			_, err := appIf.RunResourceAction(context.Background(), &applicationpkg.ResourceActionRunRequest{
-				Name:         &appName,
-				Namespace:    obj.GetNamespace(),
-				ResourceName: objResourceName,
-				Group:        gvk.Group,
-				Kind:         gvk.Kind,
-				Action:       actionNameOnly,
-			})
-			errors.CheckError(err)
+		satisfied := false
+		var required []string
+		for _, flag := range flags[1:] {
+			satisfied = satisfied || flagSet(command, flag)
+			required = append(required, "--"+flag)
+		}
+		if !satisfied {
+			return fmt.Errorf("--%s requires %s", flags[0], strings.Join(required, " or "))
 		}
 	}
-	return command
+	return nil
+}
+
+// sourcePathFlagHelp is the help of the --source-path flag, which is registered so that users referencing resources by
+// their manifest file get a clear explanation rather than an unknown flag error
+const sourcePathFlagHelp = "Select the resources declared in the manifest file at this path of the application source. Not supported yet since the server does not track the manifest file each managed resource originated from"
+
+// validateSourcePath returns an error if --source-path is set. Selecting resources by their manifest file requires
+// the server to record the source path of each managed resource, which it does not.
+func validateSourcePath(command *cobra.Command) error {
+	if !flagSet(command, "source-path") {
+		return nil
+	}
+	return fmt.Errorf("--source-path is not supported: the server does not track the manifest file each managed resource originated from. Select the resources with --kind, --resource-name or --selector instead")
+}
+
+// getServerVersion returns the version of the connected server, or nil if it cannot be determined
+func getServerVersion(ctx context.Context, acdClient argocdclient.Client) *semver.Version {
+	conn, versionIf := acdClient.NewVersionClientOrDie()
+	defer util.Close(conn)
+	serverVers, err := versionIf.Version(ctx, &empty.Empty{})
+	if err != nil {
+		log.Warnf("Failed to determine server version: %v", err)
+		return nil
+	}
+	log.Debugf("Connected to argocd-server %s", serverVers.Version)
+	// remove pre-release part, e.g. v1.3.0-rc1+abcdef
+	version, err := semver.NewVersion(strings.Split(serverVers.Version, "-")[0])
+	if err != nil {
+		log.Warnf("Failed to parse server version '%s': %v", serverVers.Version, err)
+		return nil
+	}
+	return version
 }
 
-func parseActionName(action string) (string, string, string) {
-	actionSplit := strings.Split(action, "/")
-	if len(actionSplit) != 3 {
-		log.Fatal("Action name is malformed")
+// refreshApplication refreshes the application and waits for the refresh to complete, so that its managed resources
+// reflect the current state in the cluster rather than the cached state
+func refreshApplication(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName string, hardRefresh bool) error {
+	_, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, Refresh: getRefreshType(true, hardRefresh)})
+	if err != nil {
+		return fmt.Errorf("Failed to refresh application '%s': %v", appName, err)
+	}
+	refreshType := "refresh"
+	if hardRefresh {
+		refreshType = "hard refresh"
 	}
-	return actionSplit[0], actionSplit[1], actionSplit[2]
+	log.Infof("Triggered a %s of application '%s' before listing actions", refreshType, appName)
+	return nil
+}
+
+// resourceActionsKey returns the key identifying the object in the available actions map
+func resourceActionsKey(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group + "\t" + gvk.Kind + "\t" + obj.GetNamespace() + "\t" + obj.GetName()
 }
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+)
+
+// auditRecord is the record of an action run on a resource, which is posted to the --audit-sink
+type auditRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	User      string    `json:"user,omitempty"`
+	App       string    `json:"app"`
+	Group     string    `json:"group"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Action    string    `json:"action"`
+	// Result is either "succeeded" or "failed"
+	Result  string `json:"result"`
+	Message string `json:"message,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// auditSinkAttempts is the number of times a record is posted to the audit sink before giving up on it, and
+// auditSinkRetryDelay the delay before the first retry, which doubles with every retry
+var (
+	auditSinkAttempts   = 3
+	auditSinkRetryDelay = time.Second
+)
+
+// auditSinkParallelism is the number of records posted to the audit sink concurrently
+const auditSinkParallelism = 10
+
+// newAuditSinkClient returns the HTTP client posting to the audit sink, which times out each request after the given
+// number of seconds
+func newAuditSinkClient(timeoutSeconds uint) *http.Client {
+	return &http.Client{Timeout: time.Duration(timeoutSeconds) * time.Second}
+}
+
+// newAuditRecords returns the audit records of the results of actions run by user. The application of results which
+// do not have one is app.
+func newAuditRecords(results []actionResult, app string, user string, now time.Time) []auditRecord {
+	var records []auditRecord
+	for _, result := range results {
+		record := auditRecord{
+			Timestamp: now.UTC(),
+			User:      user,
+			App:       result.App,
+			Group:     result.Group,
+			Kind:      result.Kind,
+			Namespace: result.Namespace,
+			Name:      result.Name,
+			Action:    result.Action,
+			Result:    "succeeded",
+		}
+		if record.App == "" {
+			record.App = app
+		}
+		if result.Error != nil {
+			record.Result = "failed"
+			record.Message = result.Error.Error()
+			if result.Reason != applicationpkg.ResourceActionFailureReason_Unknown {
+				record.Reason = result.Reason.String()
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// postAuditRecord posts the JSON of the record to the audit sink. Network errors and server errors are retried until
+// the context is done.
+func postAuditRecord(ctx context.Context, client *http.Client, sinkURL string, record auditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	delay := auditSinkRetryDelay
+	for attempt := 1; ; attempt++ {
+		var req *http.Request
+		req, err = http.NewRequest(http.MethodPost, sinkURL, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		var res *http.Response
+		res, err = client.Do(req.WithContext(ctx))
+		if err == nil {
+			_ = res.Body.Close()
+			if res.StatusCode < 300 {
+				return nil
+			}
+			err = fmt.Errorf("the audit sink responded with %s", res.Status)
+			if res.StatusCode < 500 && res.StatusCode != http.StatusTooManyRequests {
+				return err
+			}
+		}
+		if attempt >= auditSinkAttempts {
+			return err
+		}
+		log.Debugf("Failed to post the audit record of %s %s/%s: %v, retrying in %v", record.Kind, record.Namespace, record.Name, err, delay)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// postAuditRecords posts a record per result to the audit sink, auditSinkParallelism records at a time. The records
+// which were not posted within auditSinkAttempts times the timeout of the client are given up on. Failures to post
+// are only warned about, so that the outcome of the actions is still reported.
+func postAuditRecords(client *http.Client, sinkURL string, records []auditRecord) {
+	ctx := context.Background()
+	if client.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(auditSinkAttempts)*client.Timeout)
+		defer cancel()
+	}
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, auditSinkParallelism)
+	failed := 0
+	for _, record := range records {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(record auditRecord) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := postAuditRecord(ctx, client, sinkURL, record); err != nil {
+				lock.Lock()
+				failed++
+				lock.Unlock()
+				log.Warnf("Failed to post the audit record of %s %s/%s to %s: %v", record.Kind, record.Namespace, record.Name, sinkURL, err)
+			}
+		}(record)
+	}
+	wg.Wait()
+	if failed > 0 {
+		log.Warnf("%d of %d audit records were not posted to %s", failed, len(records), sinkURL)
+	}
+}
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+)
+
+func TestPostAuditRecords(t *testing.T) {
+	defer func(attempts int, delay time.Duration) {
+		auditSinkAttempts, auditSinkRetryDelay = attempts, delay
+	}(auditSinkAttempts, auditSinkRetryDelay)
+	auditSinkAttempts, auditSinkRetryDelay = 3, time.Millisecond
+
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	records := newAuditRecords([]actionResult{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", Action: "restart"},
+		{App: "other", Group: "apps", Kind: "Deployment", Namespace: "default", Name: "api", Action: "restart", Error: fmt.Errorf("forbidden"), Reason: applicationpkg.ResourceActionFailureReason_Forbidden},
+	}, "guestbook", "admin", now)
+	assert.Equal(t, []auditRecord{
+		{Timestamp: now, User: "admin", App: "guestbook", Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", Action: "restart", Result: "succeeded"},
+		{Timestamp: now, User: "admin", App: "other", Group: "apps", Kind: "Deployment", Namespace: "default", Name: "api", Action: "restart", Result: "failed", Message: "forbidden", Reason: "Forbidden"},
+	}, records)
+
+	var lock sync.Mutex
+	var received []auditRecord
+	requests := 0
+	attempts := make(map[string]int)
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		requests++
+		var record auditRecord
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&record))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		// the first attempt of each record fails
+		attempts[record.Name]++
+		if attempts[record.Name] == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received = append(received, record)
+	}))
+	defer sink.Close()
+	postAuditRecords(sink.Client(), sink.URL, records)
+	assert.Equal(t, 4, requests)
+	// the records are posted concurrently
+	assert.ElementsMatch(t, records, received)
+
+	// client errors are not retried, and failures are only warned about
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer rejecting.Close()
+	requests = 0
+	hook := test.NewGlobal()
+	defer hook.Reset()
+	postAuditRecords(rejecting.Client(), rejecting.URL, records[:1])
+	assert.Equal(t, 1, requests)
+	if assert.NotNil(t, hook.LastEntry()) {
+		assert.Equal(t, log.WarnLevel, hook.LastEntry().Level)
+		assert.Equal(t, fmt.Sprintf("1 of 1 audit records were not posted to %s", rejecting.URL), hook.LastEntry().Message)
+	}
+
+	// network errors are retried until the attempts are exhausted
+	assert.Error(t, postAuditRecord(context.Background(), rejecting.Client(), "http://127.0.0.1:1", records[0]))
+
+	// a sink which never responds is given up on once the requests time out
+	release := make(chan struct{})
+	hanging := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer hanging.Close()
+	defer close(release)
+	client := hanging.Client()
+	client.Timeout = 50 * time.Millisecond
+	start := time.Now()
+	postAuditRecords(client, hanging.URL, records)
+	assert.True(t, time.Since(start) < 5*time.Second)
+	if assert.NotNil(t, hook.LastEntry()) {
+		assert.Equal(t, fmt.Sprintf("2 of 2 audit records were not posted to %s", hanging.URL), hook.LastEntry().Message)
+	}
+}
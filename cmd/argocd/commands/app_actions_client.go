@@ -0,0 +1,192 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+)
+
+// verboseAppClient is an application client which logs the resource action calls it makes at debug level
+type verboseAppClient struct {
+	applicationpkg.ApplicationServiceClient
+}
+
+func (c *verboseAppClient) ListResourceActions(ctx context.Context, in *applicationpkg.ApplicationResourceRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionsListResponse, error) {
+	res, err := c.ApplicationServiceClient.ListResourceActions(ctx, in, opts...)
+	log.WithFields(log.Fields{
+		"application": in.GetName(),
+		"group":       in.Group,
+		"kind":        in.Kind,
+		"namespace":   in.Namespace,
+		"name":        in.ResourceName,
+	}).Debugf("ListResourceActions: %s", status.Code(err))
+	return res, err
+}
+
+func (c *verboseAppClient) ListResourceActionsBatch(ctx context.Context, in *applicationpkg.ResourceActionsListBatchRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionsListBatchResponse, error) {
+	res, err := c.ApplicationServiceClient.ListResourceActionsBatch(ctx, in, opts...)
+	log.WithFields(log.Fields{
+		"application": in.GetName(),
+		"targets":     len(in.Targets),
+	}).Debugf("ListResourceActionsBatch: %s", status.Code(err))
+	return res, err
+}
+
+func (c *verboseAppClient) RunResourceAction(ctx context.Context, in *applicationpkg.ResourceActionRunRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionRunResponse, error) {
+	res, err := c.ApplicationServiceClient.RunResourceAction(ctx, in, opts...)
+	entry := log.WithFields(log.Fields{
+		"application": in.GetName(),
+		"group":       in.Group,
+		"kind":        in.Kind,
+		"namespace":   in.Namespace,
+		"name":        in.ResourceName,
+		"action":      in.Action,
+		"revision":    in.Revision,
+	})
+	entry.Debugf("RunResourceAction: %s", status.Code(err))
+	if stackTrace := actionErrorStackTrace(err); len(stackTrace) > 0 {
+		entry.Debugf("Lua stack traceback:\n\t%s", strings.Join(stackTrace, "\n\t"))
+	}
+	return res, err
+}
+
+func (c *verboseAppClient) RunResourceActions(ctx context.Context, in *applicationpkg.ResourceActionsRunRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionsRunResponse, error) {
+	res, err := c.ApplicationServiceClient.RunResourceActions(ctx, in, opts...)
+	log.WithFields(log.Fields{
+		"application": in.GetName(),
+		"action":      in.Action,
+		"revision":    in.Revision,
+		"targets":     len(in.Targets),
+	}).Debugf("RunResourceActions: %s", status.Code(err))
+	return res, err
+}
+
+// callTimeoutAppClient is an application client which times out each resource action call after the given timeout
+type callTimeoutAppClient struct {
+	applicationpkg.ApplicationServiceClient
+	timeout time.Duration
+}
+
+func (c *callTimeoutAppClient) RunResourceAction(ctx context.Context, in *applicationpkg.ResourceActionRunRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionRunResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.ApplicationServiceClient.RunResourceAction(ctx, in, opts...)
+}
+
+func (c *callTimeoutAppClient) RunResourceActions(ctx context.Context, in *applicationpkg.ResourceActionsRunRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionsRunResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.ApplicationServiceClient.RunResourceActions(ctx, in, opts...)
+}
+
+// withCallTimeout wraps the application client so that resource action calls time out after the given timeout. A
+// timeout of zero disables it.
+func withCallTimeout(appIf applicationpkg.ApplicationServiceClient, timeout time.Duration) applicationpkg.ApplicationServiceClient {
+	if timeout <= 0 {
+		return appIf
+	}
+	return &callTimeoutAppClient{appIf, timeout}
+}
+
+// managedResourcesTimeoutAppClient is an application client which times out the listing of managed resources after
+// the given timeout
+type managedResourcesTimeoutAppClient struct {
+	applicationpkg.ApplicationServiceClient
+	timeout time.Duration
+}
+
+func (c *managedResourcesTimeoutAppClient) ManagedResources(ctx context.Context, in *applicationpkg.ResourcesQuery, opts ...grpc.CallOption) (*applicationpkg.ManagedResourcesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	res, err := c.ApplicationServiceClient.ManagedResources(ctx, in, opts...)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("Listing the managed resources of application '%s' timed out after %v. Narrow down the application or increase --managed-resources-timeout", in.GetApplicationName(), c.timeout)
+	}
+	return res, err
+}
+
+// withManagedResourcesTimeout wraps the application client so that listing managed resources times out after the
+// given timeout. A timeout of zero disables it.
+func withManagedResourcesTimeout(appIf applicationpkg.ApplicationServiceClient, timeout time.Duration) applicationpkg.ApplicationServiceClient {
+	if timeout <= 0 {
+		return appIf
+	}
+	return &managedResourcesTimeoutAppClient{appIf, timeout}
+}
+
+// hookAppClient is an application client which runs local shell commands before and after each resource action call
+type hookAppClient struct {
+	applicationpkg.ApplicationServiceClient
+	beforeHook   string
+	afterHook    string
+	ignoreErrors bool
+	// output receives the output of the hooks
+	output io.Writer
+}
+
+// runHook runs the shell command with the identity of the resource of the action call in its environment
+func (c *hookAppClient) runHook(hook string, in *applicationpkg.ResourceActionRunRequest, actionErr error) error {
+	cmd := exec.Command("sh", "-c", hook)
+	cmd.Stdout = c.output
+	cmd.Stderr = c.output
+	cmd.Env = append(os.Environ(),
+		"ARGOCD_APP_NAME="+in.GetName(),
+		"ARGOCD_ACTION="+in.Action,
+		"ARGOCD_RESOURCE_GROUP="+in.Group,
+		"ARGOCD_RESOURCE_KIND="+in.Kind,
+		"ARGOCD_RESOURCE_NAMESPACE="+in.Namespace,
+		"ARGOCD_RESOURCE_NAME="+in.ResourceName,
+	)
+	if actionErr != nil {
+		cmd.Env = append(cmd.Env, "ARGOCD_ACTION_ERROR="+actionErr.Error())
+	}
+	return cmd.Run()
+}
+
+func (c *hookAppClient) RunResourceAction(ctx context.Context, in *applicationpkg.ResourceActionRunRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionRunResponse, error) {
+	if c.beforeHook != "" {
+		if err := c.runHook(c.beforeHook, in, nil); err != nil {
+			if !c.ignoreErrors {
+				return nil, fmt.Errorf("before hook failed: %v", err)
+			}
+			log.Warnf("Before hook failed on %s %s/%s: %v", in.Kind, in.Namespace, in.ResourceName, err)
+		}
+	}
+	res, err := c.ApplicationServiceClient.RunResourceAction(ctx, in, opts...)
+	if c.afterHook != "" {
+		// the action has already run, so a failing after hook does not fail it
+		if hookErr := c.runHook(c.afterHook, in, err); hookErr != nil {
+			log.Warnf("After hook failed on %s %s/%s: %v", in.Kind, in.Namespace, in.ResourceName, hookErr)
+		}
+	}
+	return res, err
+}
+
+// withActionHooks wraps the application client so that the given hooks are run before and after each resource
+// action call. A failing before hook fails the call without running the action unless ignoreErrors is set.
+func withActionHooks(appIf applicationpkg.ApplicationServiceClient, beforeHook, afterHook string, ignoreErrors bool) applicationpkg.ApplicationServiceClient {
+	if beforeHook == "" && afterHook == "" {
+		return appIf
+	}
+	return &hookAppClient{appIf, beforeHook, afterHook, ignoreErrors, os.Stderr}
+}
+
+// withVerboseLogging wraps the application client so that resource action calls are logged if verbose is set, unless
+// --quiet takes precedence
+func withVerboseLogging(appIf applicationpkg.ApplicationServiceClient, verbose bool) applicationpkg.ApplicationServiceClient {
+	if !verbose || quietOutput {
+		return appIf
+	}
+	log.SetLevel(log.DebugLevel)
+	return &verboseAppClient{appIf}
+}
@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestVerboseAppClient(t *testing.T) {
+	hook := test.NewGlobal()
+	defer hook.Reset()
+	level := log.GetLevel()
+	defer log.SetLevel(level)
+
+	appName := "guestbook"
+	appIf := withVerboseLogging(&fakeAppServiceClient{}, true)
+	_, err := appIf.RunResourceAction(context.Background(), &applicationpkg.ResourceActionRunRequest{Name: &appName, Kind: "Deployment", ResourceName: "guestbook-ui", Action: "restart"})
+	assert.NoError(t, err)
+
+	entry := hook.LastEntry()
+	if assert.NotNil(t, entry) {
+		assert.Equal(t, log.DebugLevel, entry.Level)
+		assert.Equal(t, "RunResourceAction: OK", entry.Message)
+		assert.Equal(t, "guestbook-ui", entry.Data["name"])
+		assert.Equal(t, "restart", entry.Data["action"])
+	}
+}
+
+// blockingAppServiceClient is an application client whose action and managed resources calls block until their
+// context is done
+type blockingAppServiceClient struct {
+	applicationpkg.ApplicationServiceClient
+}
+
+func (c *blockingAppServiceClient) RunResourceAction(ctx context.Context, in *applicationpkg.ResourceActionRunRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionRunResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (c *blockingAppServiceClient) ManagedResources(ctx context.Context, in *applicationpkg.ResourcesQuery, opts ...grpc.CallOption) (*applicationpkg.ManagedResourcesResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestCallTimeoutAppClient(t *testing.T) {
+	appIf := &blockingAppServiceClient{}
+	assert.Equal(t, appIf, withCallTimeout(appIf, 0))
+
+	appName := "guestbook"
+	_, err := withCallTimeout(appIf, 10*time.Millisecond).RunResourceAction(context.Background(), &applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestManagedResourcesTimeoutAppClient(t *testing.T) {
+	appIf := &blockingAppServiceClient{}
+	assert.Equal(t, appIf, withManagedResourcesTimeout(appIf, 0))
+
+	appName := "guestbook"
+	_, err := withManagedResourcesTimeout(appIf, 10*time.Millisecond).ManagedResources(context.Background(), &applicationpkg.ResourcesQuery{ApplicationName: &appName})
+	assert.EqualError(t, err, "Listing the managed resources of application 'guestbook' timed out after 10ms. Narrow down the application or increase --managed-resources-timeout")
+
+	// calls which complete in time are not affected
+	managed := &fakeAppServiceClient{managed: map[string][]*argoappv1.ResourceDiff{appName: {{Kind: "Deployment", Name: "guestbook-ui"}}}}
+	res, err := withManagedResourcesTimeout(managed, time.Minute).ManagedResources(context.Background(), &applicationpkg.ResourcesQuery{ApplicationName: &appName})
+	assert.NoError(t, err)
+	assert.Len(t, res.Items, 1)
+}
+
+func TestHookAppClient(t *testing.T) {
+	assert.Equal(t, &fakeAppServiceClient{}, withActionHooks(&fakeAppServiceClient{}, "", "", false))
+
+	appName := "guestbook"
+	req := &applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart", Group: "apps", Kind: "Deployment", Namespace: "prod", ResourceName: "guestbook-ui"}
+	newClient := func(fake *fakeAppServiceClient, beforeHook, afterHook string, ignoreErrors bool) (*hookAppClient, *bytes.Buffer) {
+		var out bytes.Buffer
+		return &hookAppClient{fake, beforeHook, afterHook, ignoreErrors, &out}, &out
+	}
+
+	fake := &fakeAppServiceClient{}
+	client, out := newClient(fake, `echo before $ARGOCD_APP_NAME $ARGOCD_ACTION $ARGOCD_RESOURCE_GROUP/$ARGOCD_RESOURCE_KIND $ARGOCD_RESOURCE_NAMESPACE/$ARGOCD_RESOURCE_NAME`, `echo "after [$ARGOCD_ACTION_ERROR]"`, false)
+	_, err := client.RunResourceAction(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "before guestbook restart apps/Deployment prod/guestbook-ui\nafter []\n", out.String())
+	assert.Len(t, fake.requests, 1)
+
+	// the after hook is passed the error of the action
+	fake = &fakeAppServiceClient{runErr: func(*applicationpkg.ResourceActionRunRequest) error { return fmt.Errorf("action failed") }}
+	client, out = newClient(fake, "", `echo "after [$ARGOCD_ACTION_ERROR]"; exit 1`, false)
+	_, err = client.RunResourceAction(context.Background(), req)
+	assert.EqualError(t, err, "action failed")
+	assert.Equal(t, "after [action failed]\n", out.String())
+
+	// a failing before hook prevents the action from being run unless hook errors are ignored
+	fake = &fakeAppServiceClient{}
+	client, _ = newClient(fake, "exit 1", "", false)
+	_, err = client.RunResourceAction(context.Background(), req)
+	assert.EqualError(t, err, "before hook failed: exit status 1")
+	assert.Empty(t, fake.requests)
+
+	client, _ = newClient(fake, "exit 1", "", true)
+	_, err = client.RunResourceAction(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Len(t, fake.requests, 1)
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// NewApplicationResourceActionsExportCommand returns a new instance of an `argocd app actions export` command
+func NewApplicationResourceActionsExportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	var command = &cobra.Command{
+		Use:   "export",
+		Short: "Exports the definitions of all resource actions of Argo CD, whether built-in or configured as resource customizations",
+		Long: `Exports the definitions of all resource actions of Argo CD, regardless of the resources managed by applications.
+
+Each kind of resources is listed with the source of its actions: "builtin" for the actions shipped with Argo CD, or
+"customization" for the actions configured in the resource customizations of the argocd-cm ConfigMap, which take
+precedence over the built-in ones. E.g. to back up the action definitions:
+
+	argocd app actions export > actions.yaml`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(exitCodeInvalidArgs)
+			}
+			if output != "yaml" && output != "json" {
+				fatalWithCode(exitCodeInvalidArgs, "Unsupported output format '%s'. One of: yaml, json", output)
+			}
+			conn, settingsIf := argocdclient.NewClientOrDie(clientOpts).NewSettingsClientOrDie()
+			defer util.Close(conn)
+			list, err := settingsIf.ListResourceActions(context.Background(), &settingspkg.ResourceActionsQuery{})
+			if status.Code(err) == codes.Unimplemented {
+				fatalWithCode(exitCodeInvalidArgs, "Exporting resource actions requires argocd-server v1.3.0 or later")
+			}
+			checkStatusError(err)
+			checkStatusError(printResourceActionsExport(os.Stdout, list.Items, output))
+		},
+	}
+	command.Flags().StringVarP(&output, "out", "o", "yaml", "Output format. One of: yaml, json")
+	return command
+}
+
+// printResourceActionsExport prints the exported resource actions in the given output format
+func printResourceActionsExport(w io.Writer, items []settingspkg.ResourceActionsEntry, output string) error {
+	if items == nil {
+		items = []settingspkg.ResourceActionsEntry{}
+	}
+	var data []byte
+	var err error
+	switch output {
+	case "yaml":
+		data, err = yaml.Marshal(items)
+	default:
+		data, err = json.MarshalIndent(items, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestPrintResourceActionsExport(t *testing.T) {
+	items := []settingspkg.ResourceActionsEntry{{
+		Group:  "argoproj.io",
+		Kind:   "Rollout",
+		Source: "builtin",
+		Actions: argoappv1.ResourceActions{
+			ActionDiscoveryLua: "actions = {}\nreturn actions",
+			Definitions:        []argoappv1.ResourceActionDefinition{{Name: "resume", ActionLua: "return obj"}},
+		},
+	}}
+	var out bytes.Buffer
+	assert.NoError(t, printResourceActionsExport(&out, items, "yaml"))
+	assert.Equal(t, `- actions:
+    definitions:
+    - action.lua: return obj
+      name: resume
+    discovery.lua: |-
+      actions = {}
+      return actions
+  group: argoproj.io
+  kind: Rollout
+  source: builtin
+
+`, out.String())
+
+	out.Reset()
+	assert.NoError(t, printResourceActionsExport(&out, nil, "json"))
+	assert.Equal(t, "[]\n", out.String())
+}
@@ -0,0 +1,314 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/localconfig"
+)
+
+// newLastActionRun returns the invocation of the run command with the flags which were set on it
+func newLastActionRun(command *cobra.Command, appName string, actionName string) localconfig.ActionRun {
+	run := localconfig.ActionRun{App: appName, Action: actionName}
+	command.Flags().Visit(func(flag *pflag.Flag) {
+		if flag.Name != "save-last" {
+			run.Flags = append(run.Flags, fmt.Sprintf("--%s=%s", flag.Name, flag.Value.String()))
+		}
+	})
+	return run
+}
+
+// saveLastActionRun saves the invocation of the run command in the local config as the last run of the application
+func saveLastActionRun(clientOpts *argocdclient.ClientOptions, run localconfig.ActionRun) {
+	localCfg, err := localconfig.ReadLocalConfig(clientOpts.ConfigPath)
+	checkStatusError(err)
+	if localCfg == nil {
+		fatalWithCode(exitCodeInvalidArgs, "Cannot save action run: no local config found")
+	}
+	localCfg.UpsertLastActionRun(run)
+	checkStatusError(localconfig.WriteLocalConfig(*localCfg, clientOpts.ConfigPath))
+}
+
+// NewApplicationResourceActionsReplayCommand returns a new instance of an `argocd app actions replay` command
+func NewApplicationResourceActionsReplayCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "replay APPNAME",
+		Short: "Re-executes the action run of an application which was saved with 'argocd app actions run --save-last'",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(exitCodeInvalidArgs)
+			}
+			localCfg, err := localconfig.ReadLocalConfig(clientOpts.ConfigPath)
+			checkStatusError(err)
+			if localCfg == nil {
+				fatalWithCode(exitCodeInvalidArgs, "No local config found")
+			}
+			run, err := localCfg.GetLastActionRun(args[0])
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "%v", err)
+			}
+			runCommand, runArgs := newReplayRunCommand(clientOpts, run)
+			fmt.Fprintf(diagnosticOutput, "Replaying: %s\n", formatActionRun(run))
+			runCommand.Run(runCommand, runArgs)
+		},
+	}
+	return command
+}
+
+// NewApplicationResourceActionsHistoryCommand returns a new instance of an `argocd app actions history` command
+func NewApplicationResourceActionsHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	var command = &cobra.Command{
+		Use:   "history APPNAME",
+		Short: "Lists the recorded action runs of an application",
+		Long: `Lists the action runs recorded in the event history of an application, along with the reason given for them.
+
+Action runs are recorded if they are run with --record or --reason, e.g.:
+
+	argocd app actions run APPNAME apps/Deployment/restart --all --reason "INC-1234: pods stuck after node drain"
+
+The event history only covers the events which have not expired yet, by default those of the last hour.`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(exitCodeInvalidArgs)
+			}
+			if output != "" && output != "yaml" && output != "json" {
+				fatalWithCode(exitCodeInvalidArgs, "Unsupported output format '%s'. One of: yaml, json", output)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			events, err := appIf.ListResourceEvents(context.Background(), &applicationpkg.ApplicationResourceEventsQuery{Name: &appName})
+			checkStatusError(err)
+			checkStatusError(printActionHistory(os.Stdout, actionHistory(events.Items), output))
+		},
+	}
+	command.Flags().StringVarP(&output, "out", "o", "", "Output format. One of: yaml, json")
+	return command
+}
+
+// NewApplicationResourceActionsUndoCommand returns a new instance of an `argocd app actions undo` command
+func NewApplicationResourceActionsUndoCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var namespace string
+	var resourceName string
+	var selector string
+	var all bool
+	var reason string
+	var output string
+	var command = &cobra.Command{
+		Use:   "undo APPNAME ACTION",
+		Short: "Undoes a reversible action by running its inverse action on the resources",
+		Long: `Undoes an action on the selected resources by running the inverse action it declares. Only actions whose discovery
+declares an inverse are reversible, e.g. the built-in pause and resume actions of Argo Rollouts. To undo pausing a
+rollout:
+
+	argocd app actions undo guestbook argoproj.io/Rollout/pause --resource-name guestbook-ui
+
+Custom actions declare their inverse in the discovery.lua of their resource customization, e.g.
+actions["scale-down"] = {["inverse"] = "scale-up"}.`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(exitCodeInvalidArgs)
+			}
+			if output != "" && output != "yaml" && output != "json" && output != "jsonl" {
+				fatalWithCode(exitCodeInvalidArgs, "Unsupported output format '%s'. One of: yaml, json, jsonl", output)
+			}
+			appName := args[0]
+			labelSelector := parseSelector(selector)
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			ctx := context.Background()
+			resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
+			checkStatusError(err)
+			group, kind, actionName, err := resolveActionName(resources.Items, args[1])
+			if _, ok := err.(noMatchError); ok {
+				fatalWithCode(exitCodeNoMatch, "%v", err)
+			} else if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "%v", err)
+			}
+			filteredObjects := filterResources(c, resources.Items, group, parseKinds(kind), namespace, resourceName, labelSelector, all)
+			inverses, err := inverseActions(ctx, appIf, appName, filteredObjects, actionName)
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "%v", err)
+			}
+			var results []actionResult
+			for _, inverse := range inverses {
+				req := applicationpkg.ResourceActionRunRequest{Name: &appName, Action: inverse.action, Reason: reason}
+				results = append(results, runResourceActions(ctx, appIf, req, inverse.objs, 1, 0, newActionRateLimiter(0), false, 1)...)
+				if countFailedResults(results) > 0 {
+					break
+				}
+			}
+			if output != "" {
+				checkStatusError(printActionResults(os.Stdout, results, output))
+			} else {
+				for _, result := range results {
+					if result.Error == nil {
+						fmt.Printf("undid %s on %s %s/%s by running %s\n", actionName, result.Kind, result.Namespace, result.Name, result.Action)
+					}
+				}
+			}
+			for _, result := range results {
+				if result.Error != nil {
+					fatalWithCode(actionFailureExitCode(results), "%s", formatStatusError(result.Error))
+				}
+			}
+		},
+	}
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace. Cluster-scoped resources match any namespace")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Label selector, supporting equality-based and set-based requirements (e.g. -l 'env in (staging,prod),tier!=cache')")
+	command.Flags().BoolVar(&all, "all", false, "Indicates whether to undo the action on multiple matching resources")
+	command.Flags().StringVar(&reason, "reason", "", "Reason for undoing the action, recorded in the event of each action run")
+	command.Flags().StringVarP(&output, "out", "o", "", "Output format of the results of the inverse actions. One of: yaml, json, jsonl")
+	return command
+}
+
+// inverseAction is an inverse action along with the objects it undoes an action on
+type inverseAction struct {
+	action string
+	objs   []*unstructured.Unstructured
+}
+
+// inverseActions returns the inverse of the action declared by each of the objects, grouping the objects which
+// declare the same inverse in the order they are given. It fails if the action is not defined for an object, or is
+// not reversible.
+func inverseActions(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName string, objs []*unstructured.Unstructured, action string) ([]inverseAction, error) {
+	var inverses []inverseAction
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		actions, err := appIf.ListResourceActions(ctx, &applicationpkg.ApplicationResourceRequest{
+			Name:         &appName,
+			Namespace:    obj.GetNamespace(),
+			ResourceName: obj.GetName(),
+			Group:        gvk.Group,
+			Kind:         gvk.Kind,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var inverse string
+		found := false
+		for _, a := range actions.Actions {
+			if a.Name == action {
+				inverse = a.Inverse
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("Action '%s' is not defined for %s %s/%s", action, gvk.Kind, obj.GetNamespace(), obj.GetName())
+		}
+		if inverse == "" {
+			return nil, fmt.Errorf("Action '%s' of %s %s/%s is not reversible: it declares no inverse action", action, gvk.Kind, obj.GetNamespace(), obj.GetName())
+		}
+		grouped := false
+		for i := range inverses {
+			if inverses[i].action == inverse {
+				inverses[i].objs = append(inverses[i].objs, obj)
+				grouped = true
+				break
+			}
+		}
+		if !grouped {
+			inverses = append(inverses, inverseAction{action: inverse, objs: []*unstructured.Unstructured{obj}})
+		}
+	}
+	return inverses, nil
+}
+
+// actionHistoryEntry is an action run recorded in the event history of an application
+type actionHistoryEntry struct {
+	Time time.Time `json:"time"`
+	// Message describes the action run, including the user who ran it and, if recorded, the command line
+	Message string `json:"message"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// actionHistory returns the action runs recorded by the events, ordered by time
+func actionHistory(events []corev1.Event) []actionHistoryEntry {
+	entries := []actionHistoryEntry{}
+	for _, event := range events {
+		if event.Reason != argo.EventReasonResourceActionRan {
+			continue
+		}
+		entry := actionHistoryEntry{Time: eventTime(event), Message: event.Message}
+		if reason, ok := event.Annotations[common.AnnotationKeyActionReason]; ok {
+			entry.Reason = reason
+			// the reason is printed in a column of its own
+			entry.Message = strings.TrimSuffix(entry.Message, fmt.Sprintf(" (reason: %s)", reason))
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	return entries
+}
+
+// printActionHistory prints the action history as a table, or in the given output format
+func printActionHistory(w io.Writer, entries []actionHistoryEntry, output string) error {
+	switch output {
+	case "yaml", "json":
+		var data []byte
+		var err error
+		if output == "yaml" {
+			data, err = yaml.Marshal(entries)
+		} else {
+			data, err = json.MarshalIndent(entries, "", "  ")
+		}
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "TIME\tMESSAGE\tREASON\n")
+	for _, entry := range entries {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", entry.Time.Format(time.RFC3339), entry.Message, entry.Reason)
+	}
+	return tw.Flush()
+}
+
+// newReplayRunCommand returns a run command with the flags of the saved run set, along with its arguments
+func newReplayRunCommand(clientOpts *argocdclient.ClientOptions, run *localconfig.ActionRun) (*cobra.Command, []string) {
+	runCommand := NewApplicationResourceActionsRunCommand(clientOpts)
+	if err := runCommand.Flags().Parse(run.Flags); err != nil {
+		fatalWithCode(exitCodeInvalidArgs, "Invalid saved action run: %v", err)
+	}
+	return runCommand, []string{run.App, run.Action}
+}
+
+// formatActionRun returns the command line of the saved run
+func formatActionRun(run *localconfig.ActionRun) string {
+	parts := []string{"argocd", "app", "actions", "run", run.App, run.Action}
+	for _, flag := range run.Flags {
+		if strings.ContainsAny(flag, " \t'\"()") {
+			flag = "'" + strings.Replace(flag, "'", `'\''`, -1) + "'"
+		}
+		parts = append(parts, flag)
+	}
+	return strings.Join(parts, " ")
+}
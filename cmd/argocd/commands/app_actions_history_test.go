@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/localconfig"
+)
+
+func TestSaveAndReplayLastActionRun(t *testing.T) {
+	configPath, cleanup := writeTestLocalConfig(t, func(cfg *localconfig.LocalConfig) {})
+	defer cleanup()
+	clientOpts := &argocdclient.ClientOptions{ConfigPath: configPath}
+
+	command := NewApplicationResourceActionsRunCommand(clientOpts)
+	assert.NoError(t, command.Flags().Parse([]string{"--kind=Deployment", "-l", "env in (prod)", "--all", "--save-last"}))
+	lastRun := newLastActionRun(command, "guestbook", "apps/Deployment/restart")
+	// flags set later on, e.g. by a selector profile, are not saved
+	assert.NoError(t, command.Flags().Set("namespace", "default"))
+	saveLastActionRun(clientOpts, lastRun)
+
+	localCfg, err := localconfig.ReadLocalConfig(configPath)
+	assert.NoError(t, err)
+	run, err := localCfg.GetLastActionRun("guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"--all=true", "--kind=Deployment", "--selector=env in (prod)"}, run.Flags)
+	assert.Equal(t, "argocd app actions run guestbook apps/Deployment/restart --all=true --kind=Deployment '--selector=env in (prod)'", formatActionRun(run))
+
+	replay, args := newReplayRunCommand(clientOpts, run)
+	assert.Equal(t, []string{"guestbook", "apps/Deployment/restart"}, args)
+	assert.Equal(t, "Deployment", replay.Flags().Lookup("kind").Value.String())
+	assert.Equal(t, "env in (prod)", replay.Flags().Lookup("selector").Value.String())
+	assert.True(t, replay.Flags().Changed("all"))
+	assert.False(t, replay.Flags().Changed("save-last"))
+
+	_, err = localCfg.GetLastActionRun("other")
+	assert.Error(t, err)
+}
+
+// inverseAppServiceClient lists the pause and resume actions of rollouts, and an irreversible restart action
+type inverseAppServiceClient struct {
+	applicationpkg.ApplicationServiceClient
+}
+
+func (c *inverseAppServiceClient) ListResourceActions(ctx context.Context, in *applicationpkg.ApplicationResourceRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionsListResponse, error) {
+	if in.Kind != "Rollout" {
+		return &applicationpkg.ResourceActionsListResponse{Actions: []argoappv1.ResourceAction{{Name: "restart", Available: true}}}, nil
+	}
+	return &applicationpkg.ResourceActionsListResponse{Actions: []argoappv1.ResourceAction{
+		{Name: "pause", Inverse: "resume"},
+		{Name: "resume", Available: true, Inverse: "pause"},
+	}}, nil
+}
+
+func TestInverseActions(t *testing.T) {
+	newRollout := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "argoproj.io/v1alpha1",
+			"kind":       "Rollout",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		}}
+	}
+	client := &inverseAppServiceClient{}
+	objs := []*unstructured.Unstructured{newRollout("a"), newRollout("b")}
+	inverses, err := inverseActions(context.Background(), client, "guestbook", objs, "pause")
+	assert.NoError(t, err)
+	assert.Equal(t, []inverseAction{{action: "resume", objs: objs}}, inverses)
+
+	_, err = inverseActions(context.Background(), client, "guestbook", []*unstructured.Unstructured{newDeployment("default", "web")}, "restart")
+	assert.EqualError(t, err, "Action 'restart' of Deployment default/web is not reversible: it declares no inverse action")
+	_, err = inverseActions(context.Background(), client, "guestbook", objs, "abort")
+	assert.EqualError(t, err, "Action 'abort' is not defined for Rollout default/a")
+}
+
+func TestActionHistory(t *testing.T) {
+	newEvent := func(reason string, message string, minute int, annotations map[string]string) corev1.Event {
+		return corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{Annotations: annotations},
+			Reason:        reason,
+			Message:       message,
+			LastTimestamp: metav1.NewTime(time.Date(2019, 10, 1, 12, minute, 0, 0, time.UTC)),
+		}
+	}
+	entries := actionHistory([]corev1.Event{
+		newEvent(argo.EventReasonResourceActionRan, "admin ran action restart on resource apps/Deployment 'web' (reason: INC-1234)", 5, map[string]string{common.AnnotationKeyActionReason: "INC-1234"}),
+		newEvent(argo.EventReasonResourceUpdated, "admin updated application spec", 3, nil),
+		newEvent(argo.EventReasonResourceActionRan, "admin ran action resume on resource argoproj.io/Rollout 'api': argocd app actions run guestbook resume", 1, nil),
+	})
+	assert.Equal(t, []actionHistoryEntry{
+		{Time: time.Date(2019, 10, 1, 12, 1, 0, 0, time.UTC), Message: "admin ran action resume on resource argoproj.io/Rollout 'api': argocd app actions run guestbook resume"},
+		{Time: time.Date(2019, 10, 1, 12, 5, 0, 0, time.UTC), Message: "admin ran action restart on resource apps/Deployment 'web'", Reason: "INC-1234"},
+	}, entries)
+
+	var out bytes.Buffer
+	assert.NoError(t, printActionHistory(&out, entries[1:], ""))
+	assert.Equal(t, `TIME                  MESSAGE                                                     REASON
+2019-10-01T12:05:00Z  admin ran action restart on resource apps/Deployment 'web'  INC-1234
+`, out.String())
+
+	out.Reset()
+	assert.NoError(t, printActionHistory(&out, actionHistory(nil), "json"))
+	assert.Equal(t, "[]\n", out.String())
+}
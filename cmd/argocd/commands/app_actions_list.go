@@ -0,0 +1,1190 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	accountpkg "github.com/argoproj/argo-cd/pkg/apiclient/account"
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// resourceActionRow is a single resource action as presented to output templates
+type resourceActionRow struct {
+	Group string
+	Kind  string
+	// Scope is whether the resource is cluster-scoped or namespaced, i.e. Cluster or Namespaced
+	Scope     string
+	Namespace string
+	Name      string
+	Action    string
+	Available bool
+	Labels    map[string]string
+	// Conditions are the states of the resource in which the action is intended to be run
+	Conditions []string
+	// RBAC is whether the RBAC policy permits the current user to run the action, i.e. permitted or denied. Only set
+	// with --explain-rbac.
+	RBAC string
+	// Fields are the values of the columns which the wide output adds for the kind of the resource, by column name
+	Fields map[string]string
+}
+
+// resourceActionColumnNames are the names of the columns available in the table output of the list command
+var resourceActionColumnNames = []string{"group", "kind", "scope", "namespace", "name", "action", "available", "labels", "conditions", "rbac"}
+
+// defaultResourceActionColumns are the columns of the table output of the list command unless specified otherwise
+var defaultResourceActionColumns = []string{"group", "kind", "name", "action", "available"}
+
+// resourceActionColumn returns the value of the named column of the row
+func resourceActionColumn(row resourceActionRow, column string) string {
+	switch column {
+	case "group":
+		return row.Group
+	case "kind":
+		return row.Kind
+	case "scope":
+		return row.Scope
+	case "namespace":
+		return row.Namespace
+	case "name":
+		return row.Name
+	case "action":
+		return row.Action
+	case "available":
+		return strconv.FormatBool(row.Available)
+	case "labels":
+		return labels.FormatLabels(row.Labels)
+	case "conditions":
+		return strings.Join(row.Conditions, ",")
+	case "rbac":
+		return row.RBAC
+	}
+	return row.Fields[column]
+}
+
+// wideOutputHelp documents the columns of the wide output of the list command
+const wideOutputHelp = `The wide output (-o wide) prints the group, kind, scope, namespace, name, action, availability and conditions of
+each action, followed by columns showing fields of the kinds present:
+
+  Deployment   replicas, ready, up-to-date, revision
+  StatefulSet  replicas, ready
+  DaemonSet    desired, ready
+  Rollout      replicas, ready, revision, paused
+  CronJob      schedule, suspend
+  Job          completions
+
+Resources of other kinds leave these columns empty. The scope is Cluster for cluster-scoped resources, which have no
+namespace and are never filtered out by --namespace, and Namespaced otherwise.`
+
+// wideResourceActionColumns are the columns of the wide output of the list command, which adds the columns of
+// wideKindColumns for the kinds present
+var wideResourceActionColumns = []string{"group", "kind", "scope", "namespace", "name", "action", "available", "conditions"}
+
+// kindColumn is a column of the wide output of the list command showing a field of the resources of a kind
+type kindColumn struct {
+	name string
+	// path is the path of the field in the live object
+	path []string
+}
+
+// wideKindColumns are the columns which the wide output of the list command adds for well known kinds. The columns of
+// kinds of other groups with the same name, e.g. extensions/Deployment, are the same.
+var wideKindColumns = map[string][]kindColumn{
+	"Deployment": {
+		{"replicas", []string{"spec", "replicas"}},
+		{"ready", []string{"status", "readyReplicas"}},
+		{"up-to-date", []string{"status", "updatedReplicas"}},
+		{"revision", []string{"metadata", "annotations", "deployment.kubernetes.io/revision"}},
+	},
+	"StatefulSet": {
+		{"replicas", []string{"spec", "replicas"}},
+		{"ready", []string{"status", "readyReplicas"}},
+	},
+	"DaemonSet": {
+		{"desired", []string{"status", "desiredNumberScheduled"}},
+		{"ready", []string{"status", "numberReady"}},
+	},
+	"Rollout": {
+		{"replicas", []string{"spec", "replicas"}},
+		{"ready", []string{"status", "readyReplicas"}},
+		{"revision", []string{"metadata", "annotations", "rollout.argoproj.io/revision"}},
+		{"paused", []string{"spec", "paused"}},
+	},
+	"CronJob": {
+		{"schedule", []string{"spec", "schedule"}},
+		{"suspend", []string{"spec", "suspend"}},
+	},
+	"Job": {
+		{"completions", []string{"status", "succeeded"}},
+	},
+}
+
+// kindColumnValues returns the values of the wide output columns of the kind of the object, by column name. Fields
+// missing from the object are left out.
+func kindColumnValues(obj *unstructured.Unstructured) map[string]string {
+	columns, ok := wideKindColumns[obj.GetKind()]
+	if !ok {
+		return nil
+	}
+	values := make(map[string]string)
+	for _, column := range columns {
+		if value, found, _ := unstructured.NestedFieldNoCopy(obj.Object, column.path...); found && value != nil {
+			values[column.name] = fmt.Sprintf("%v", value)
+		}
+	}
+	return values
+}
+
+// withKindColumns returns the columns followed by the wide output columns of the kinds of the rows, in the order the
+// kinds first appear. Rows of other kinds leave these columns empty.
+func withKindColumns(columns []string, rows []resourceActionRow) []string {
+	result := append([]string{}, columns...)
+	for _, row := range rows {
+		for _, column := range wideKindColumns[row.Kind] {
+			if !containsString(result, column.name) {
+				result = append(result, column.name)
+			}
+		}
+	}
+	return result
+}
+
+// explainResourceActionsRBAC sets whether the RBAC policy permits the current user to run the action of each row,
+// as reported by the server. Since actions are authorized per application, the server is asked once per action.
+func explainResourceActionsRBAC(ctx context.Context, accountIf accountpkg.AccountServiceClient, app *argoappv1.Application, rows []resourceActionRow) error {
+	permitted := make(map[string]bool)
+	for i := range rows {
+		// the RBAC action of resource actions, e.g. action/apps/Deployment/restart
+		action := fmt.Sprintf("action/%s/%s/%s", rows[i].Group, rows[i].Kind, rows[i].Action)
+		allowed, ok := permitted[action]
+		if !ok {
+			res, err := accountIf.CanI(ctx, &accountpkg.CanIRequest{Resource: "applications", Action: action, Subresource: app.Spec.GetProject() + "/" + app.Name})
+			if err != nil {
+				return fmt.Errorf("Failed to check whether %s is permitted: %v", action, err)
+			}
+			allowed = res.Value == "yes"
+			permitted[action] = allowed
+		}
+		rows[i].RBAC = "denied"
+		if allowed {
+			rows[i].RBAC = "permitted"
+		}
+	}
+	return nil
+}
+
+// parseColumns parses and validates a comma separated list of column names
+func parseColumns(columns string) ([]string, error) {
+	var parsed []string
+	for _, column := range strings.Split(columns, ",") {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if column == "" {
+			continue
+		}
+		if !containsString(resourceActionColumnNames, column) {
+			return nil, fmt.Errorf("Unknown column '%s'. Available columns: %s", column, strings.Join(resourceActionColumnNames, ", "))
+		}
+		parsed = append(parsed, column)
+	}
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("At least one column must be specified")
+	}
+	return parsed, nil
+}
+
+// printResourceActionsTable prints the rows as a table with the given columns
+func printResourceActionsTable(out io.Writer, rows []resourceActionRow, columns []string) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	var header []string
+	for _, column := range columns {
+		header = append(header, strings.ToUpper(column))
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	fmt.Fprintln(out)
+	for _, row := range rows {
+		var values []string
+		for _, column := range columns {
+			values = append(values, resourceActionColumn(row, column))
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	_ = w.Flush()
+}
+
+// watchRefreshInterval is the minimum interval at which the actions are listed again with --watch. The application
+// changes made in the meantime are listed at once.
+var watchRefreshInterval = 2 * time.Second
+
+// watchedResources returns the resources matching the selectors when the actions of list --watch are listed again.
+// Unlike with filterResources, no resource matching is not an error, since the resources may be deleted while watched.
+func watchedResources(command *cobra.Command, resources []*argoappv1.ResourceDiff, group string, kinds []string, namespace, resourceName string, selector labels.Selector) ([]*unstructured.Unstructured, error) {
+	objs, err := FilterResources(resources, ResourceFilter{
+		MatchGroup: command.Flags().Changed("group"),
+		Group:      group,
+		Kinds:      kinds,
+		Namespace:  namespace,
+		Name:       resourceName,
+		Selector:   selector,
+		All:        true,
+	})
+	if _, ok := err.(noMatchError); ok {
+		return nil, nil
+	}
+	return objs, err
+}
+
+// watchResourceActions prints the table of the rows, and prints it again with the rows returned by list after each
+// application change received from events, until the context is done or events is closed. The table is only printed
+// again if it changed, following clearScreen. Failures to list the actions again are only warned about.
+func watchResourceActions(ctx context.Context, out io.Writer, rows []resourceActionRow, events <-chan *argoappv1.ApplicationWatchEvent, list func() ([]resourceActionRow, error), columns []string, clearScreen string, interval time.Duration) error {
+	var printed string
+	draw := func(rows []resourceActionRow) error {
+		var buf bytes.Buffer
+		printResourceActionsTable(&buf, rows, columns)
+		if table := buf.String(); table != printed {
+			printed = table
+			_, err := fmt.Fprint(out, clearScreen+table)
+			return err
+		}
+		return nil
+	}
+	if err := draw(rows); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-events:
+			if !ok {
+				return nil
+			}
+		}
+		// application changes usually come in bursts, e.g. while a rollout progresses
+		timer := time.NewTimer(interval)
+	coalesce:
+		for {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil
+			case _, ok := <-events:
+				if !ok {
+					timer.Stop()
+					break coalesce
+				}
+			case <-timer.C:
+				break coalesce
+			}
+		}
+		rows, err := list()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Warnf("Failed to list the actions: %v", formatStatusError(err))
+			continue
+		}
+		if err := draw(rows); err != nil {
+			return err
+		}
+	}
+}
+
+// parseTemplateFile reads and parses the Go template at the given path
+func parseTemplateFile(path string) (*template.Template, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(path).Parse(string(data))
+}
+
+// listFlagRules are the flag combinations validated by the list command
+var listFlagRules = flagRules{
+	conflicts: [][2]string{
+		{"output-template-file", "out"},
+		{"diff-against", "out"},
+		{"diff-against", "output-template-file"},
+		{"resource-tree", "out"},
+		{"resource-tree", "output-template-file"},
+		{"resource-tree", "diff-against"},
+		{"explain-rbac", "diff-against"},
+		{"explain-rbac", "resource-tree"},
+		{"watch", "output-template-file"},
+		{"watch", "diff-against"},
+		{"watch", "resource-tree"},
+		{"watch", "tee"},
+	},
+	requires: [][]string{
+		{"fail-on-diff", "diff-against"},
+		{"depth", "resource-tree"},
+		{"flow", "out"},
+	},
+}
+
+// NewApplicationResourceActionsListCommand returns a new instance of an `argocd app actions list` command
+func NewApplicationResourceActionsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var namespace string
+	var kind string
+	var group string
+	var gvkArg string
+	var resourceName string
+	var output string
+	var templateFile string
+	var showGroupAliases bool
+	var showLabels bool
+	var managedResourcesTimeout uint
+	var includeConditions bool
+	var explainRBAC bool
+	var noSummary bool
+	var resourceTree bool
+	var onlyDisabled bool
+	var flow bool
+	var treeDepth int
+	var columns string
+	var diffAgainst string
+	var failOnDiff bool
+	var profile string
+	var selectorFilePath string
+	var tee string
+	var verbose bool
+	var refresh bool
+	var hardRefresh bool
+	var selector string
+	var selectorFromResource string
+	var namespaceSelector string
+	var olderThan string
+	var maxAge string
+	var syncStatus string
+	var health string
+	var image string
+	var explain bool
+	var watch bool
+	var command = &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "Lists available actions on a resource",
+		Long:  "Lists available actions on a resource.\n\n" + wideOutputHelp + "\n\n" + actionExitCodesHelp,
+	}
+	command.Run = func(c *cobra.Command, args []string) {
+		aliases := groupAliases(clientOpts)
+		if showGroupAliases {
+			printGroupAliases(aliases)
+			return
+		}
+		if len(args) != 1 {
+			c.HelpFunc()(c, args)
+			os.Exit(exitCodeInvalidArgs)
+		}
+		appName := args[0]
+		if err := validateFlags(command, listFlagRules); err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "%v", err)
+		}
+		if err := validateSourcePath(command); err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "%v", err)
+		}
+		if profile != "" {
+			applySelectorProfile(command, clientOpts, profile)
+		}
+		var selection *selectorFile
+		if selectorFilePath != "" {
+			var err error
+			selection, err = readSelectorFile(selectorFilePath)
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "Failed to load selector file: %v", err)
+			}
+			applySelectorFile(command, selection)
+		}
+		if gvkArg != "" {
+			gvk, err := parseGVK(gvkArg)
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "%v", err)
+			}
+			if group != "" || kind != "" {
+				log.Warnf("--gvk takes precedence over --group and --kind")
+			}
+			group, kind = gvk.Group, gvk.Kind
+		}
+		labelSelector := parseSelector(selector)
+		var nsSelector labels.Selector
+		if namespaceSelector != "" {
+			nsSelector = parseSelector(namespaceSelector)
+		}
+		minAge, maxAgeDuration := parseAgeFlags(olderThan, maxAge)
+		var syncStatusCode argoappv1.SyncStatusCode
+		if syncStatus != "" {
+			syncStatusCode = parseSyncStatus(syncStatus)
+		}
+		var healthStatusCode argoappv1.HealthStatusCode
+		if health != "" {
+			healthStatusCode = parseHealthStatus(health)
+		}
+		var imagePattern *regexp.Regexp
+		if image != "" {
+			imagePattern = parseImagePattern(image)
+		}
+		if output == "" {
+			// an empty output format selects the default, as it did before table was a format of its own
+			output = "table"
+		}
+		if err := validateListOutput(output); err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "%v", err)
+		}
+		if watch && output != "table" && output != "wide" {
+			fatalWithCode(exitCodeInvalidArgs, "--watch only supports the table and wide outputs")
+		}
+		if output == "wide" && command.Flags().Changed("columns") {
+			fatalWithCode(exitCodeInvalidArgs, "--columns cannot be combined with --out wide, which selects the columns by the kinds present")
+		}
+		var jp *jsonpath.JSONPath
+		if strings.HasPrefix(output, jsonPathOutputPrefix) {
+			var err error
+			jp, err = parseJSONPathOutput(output)
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "%v", err)
+			}
+		}
+		var tmpl *template.Template
+		if templateFile != "" {
+			var err error
+			tmpl, err = parseTemplateFile(templateFile)
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "Failed to load output template: %v", err)
+			}
+		}
+		if treeDepth < 0 {
+			fatalWithCode(exitCodeInvalidArgs, "--depth must not be negative")
+		}
+		if flow && output != "yaml" {
+			fatalWithCode(exitCodeInvalidArgs, "--flow is only supported with --out yaml")
+		}
+		tableColumns, err := parseColumns(columns)
+		if err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "%v", err)
+		}
+		if output == "wide" {
+			tableColumns = wideResourceActionColumns
+		}
+		if showLabels && !containsString(tableColumns, "labels") {
+			tableColumns = append(tableColumns, "labels")
+		}
+		if includeConditions && !containsString(tableColumns, "conditions") {
+			tableColumns = append(tableColumns, "conditions")
+		}
+		if explainRBAC && !containsString(tableColumns, "rbac") {
+			tableColumns = append(tableColumns, "rbac")
+		}
+		var baseline map[string][]argoappv1.ResourceAction
+		if diffAgainst != "" {
+			var err error
+			baseline, err = readResourceActionsFile(diffAgainst)
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "Failed to load baseline: %v", err)
+			}
+		}
+		out, closeTee, err := teeOutput(os.Stdout, tee)
+		if err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "Failed to open --tee file: %v", err)
+		}
+		defer closeTee()
+		acdClient := argocdclient.NewClientOrDie(clientOpts)
+		conn, appIf := acdClient.NewApplicationClientOrDie()
+		defer util.Close(conn)
+		appIf = withManagedResourcesTimeout(withVerboseLogging(appIf, verbose), time.Duration(managedResourcesTimeout)*time.Second)
+		ctx := context.Background()
+		if verbose {
+			getServerVersion(ctx, acdClient)
+		}
+		if refresh || hardRefresh {
+			checkStatusError(refreshApplication(ctx, appIf, appName, hardRefresh))
+		}
+		var accountIf accountpkg.AccountServiceClient
+		if explainRBAC {
+			accountConn, client := acdClient.NewAccountClientOrDie()
+			defer util.Close(accountConn)
+			accountIf = client
+		}
+		// inventory lists the selected resources and their actions. It is called again for each update with --watch,
+		// when the selection is neither explained nor required to match any resource.
+		inventory := func(initial bool) ([]*unstructured.Unstructured, map[string][]argoappv1.ResourceAction, []resourceActionRow, error) {
+			resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			labelSelector := labelSelector
+			if selectorFromResource != "" {
+				labelSelector = withResourceLabels(labelSelector, resources.Items, selectorFromResource, namespace)
+			}
+			if selection != nil {
+				resources.Items = filterBySelectorFile(resources.Items, selection)
+			}
+			if nsSelector != nil {
+				if resources.Items, err = filterByNamespaceSelector(resources.Items, nsSelector); err != nil {
+					return nil, nil, nil, err
+				}
+			}
+			if minAge > 0 || maxAgeDuration > 0 {
+				if resources.Items, err = filterByAge(resources.Items, time.Now(), minAge, maxAgeDuration); err != nil {
+					return nil, nil, nil, err
+				}
+			}
+			if syncStatusCode != "" || healthStatusCode != "" {
+				app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				if syncStatusCode != "" {
+					resources.Items = filterBySyncStatus(resources.Items, app.Status.Resources, syncStatusCode)
+				}
+				if healthStatusCode != "" {
+					resources.Items = filterByHealthStatus(resources.Items, app.Status.Resources, healthStatusCode)
+				}
+			}
+			if imagePattern != nil {
+				if resources.Items, err = filterByImage(resources.Items, imagePattern); err != nil {
+					return nil, nil, nil, err
+				}
+			}
+			var filteredObjects []*unstructured.Unstructured
+			if initial {
+				if explain {
+					explainResourceSelection(os.Stderr, command, resources.Items, resolveGroupAlias(group, aliases), parseKinds(kind), namespace, resourceName, labelSelector)
+				}
+				filteredObjects = filterResources(command, resources.Items, resolveGroupAlias(group, aliases), parseKinds(kind), namespace, resourceName, labelSelector, true)
+			} else {
+				filteredObjects, err = watchedResources(command, resources.Items, resolveGroupAlias(group, aliases), parseKinds(kind), namespace, resourceName, labelSelector)
+				if err != nil || len(filteredObjects) == 0 {
+					return nil, nil, nil, err
+				}
+			}
+			availableActions, rows, err := listResourceActions(ctx, appIf, appName, filteredObjects)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if onlyDisabled {
+				availableActions, rows = unavailableResourceActions(availableActions, rows)
+			}
+			if explainRBAC {
+				app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				if err := explainResourceActionsRBAC(ctx, accountIf, app, rows); err != nil {
+					return nil, nil, nil, err
+				}
+			}
+			return filteredObjects, availableActions, rows, nil
+		}
+		filteredObjects, availableActions, rows, err := inventory(true)
+		checkStatusError(err)
+		if output == "wide" {
+			tableColumns = withKindColumns(tableColumns, rows)
+		}
+
+		if watch {
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			// stop watching cleanly on SIGINT
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+			defer signal.Stop(sigCh)
+			go func() {
+				select {
+				case <-sigCh:
+					cancel()
+				case <-watchCtx.Done():
+				}
+			}()
+			clearScreen := ""
+			if terminal.IsTerminal(int(os.Stdout.Fd())) {
+				clearScreen = "\033[H\033[2J"
+			}
+			checkStatusError(watchResourceActions(watchCtx, out, rows, acdClient.WatchApplicationWithRetry(watchCtx, appName), func() ([]resourceActionRow, error) {
+				_, _, rows, err := inventory(false)
+				return rows, err
+			}, tableColumns, clearScreen, watchRefreshInterval))
+			return
+		}
+
+		if baseline != nil {
+			changes := diffResourceActions(baseline, availableActions)
+			printResourceActionChanges(out, changes)
+			if failOnDiff && len(changes) > 0 {
+				log.Fatalf("%d actions differ from %s", len(changes), diffAgainst)
+			}
+			return
+		}
+
+		if tmpl != nil {
+			for _, row := range rows {
+				checkStatusError(tmpl.Execute(out, row))
+				fmt.Fprintln(out)
+			}
+			return
+		}
+
+		if jp != nil {
+			checkStatusError(printJSONPath(out, jp, availableActions))
+			fmt.Fprintln(out)
+			return
+		}
+
+		switch output {
+		case "table", "wide":
+			if resourceTree {
+				printResourceActionsTree(out, filteredObjects, availableActions, treeDepth)
+			} else {
+				printResourceActionsTable(out, rows, tableColumns)
+			}
+			if !noSummary {
+				printResourceActionsSummary(diagnosticOutput, filteredObjects, availableActions)
+			}
+		default:
+			if err := printResourceActionsAs(out, output, flow, filteredObjects, availableActions); err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "%v", err)
+			}
+		}
+	}
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
+	command.Flags().String("source-path", "", sourcePathFlagHelp)
+	command.Flags().StringVar(&kind, "kind", "", "Kind (singular or plural, e.g. Deployment or deployments). Several kinds may be given comma separated, e.g. Deployment,StatefulSet")
+	command.Flags().StringVar(&group, "group", "", "Group, or a group alias (e.g. rollouts for argoproj.io)")
+	command.Flags().StringVar(&gvkArg, "gvk", "", "Group, version and kind in the form group/version/Kind, or version/Kind for the core group (e.g. apps/v1/Deployment). Takes precedence over --group and --kind. The version is not used to select resources")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace. Cluster-scoped resources match any namespace")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Label selector, supporting equality-based and set-based requirements (e.g. -l 'env in (staging,prod),tier!=cache')")
+	command.Flags().StringVar(&selectorFromResource, "selector-from-resource", "", "Select the resources with the labels of the managed resource KIND/NAME or GROUP/KIND/NAME, e.g. Deployment/guestbook-ui. Combined with --selector, if set")
+	command.Flags().StringVar(&namespaceSelector, "namespace-selector", "", "Namespace label selector. Only selects resources in namespaces which are managed by the application and whose labels match")
+	command.Flags().StringVar(&olderThan, "older-than", "", "Only select resources created at least this long ago, e.g. 7d or 36h")
+	command.Flags().StringVar(&maxAge, "max-age", "", "Only select resources created at most this long ago, e.g. 7d or 36h")
+	command.Flags().StringVar(&syncStatus, "sync-status", "", "Only select resources with the given sync status. One of: Synced, OutOfSync, Unknown")
+	command.Flags().StringVar(&health, "health", "", "Only select resources with the given health. One of: Healthy, Progressing, Degraded, Suspended, Missing, Unknown")
+	command.Flags().StringVar(&image, "image", "", fmt.Sprintf("Only select workloads with a container or init container whose image matches the regular expression, e.g. '^guestbook:v1\\.2'. The pod templates of the kinds %s are inspected; other resources are not selected", strings.Join(imageKinds, ", ")))
+	command.Flags().StringVarP(&output, "out", "o", "table", fmt.Sprintf("Output format. One of: %s, %sEXPRESSION", strings.Join(listOutputFormats, ", "), jsonPathOutputPrefix))
+	command.Flags().StringVar(&profile, "profile", "", "Name of a selector profile from the CLI config. Explicitly specified selector flags take precedence over the profile")
+	command.Flags().StringVar(&selectorFilePath, "selector-file", "", "Path to a yaml file with a set of selectors: kinds, namespaces, labels and exclude. The --kind, --namespace and --selector flags take precedence over the file, whether specified explicitly or by --profile")
+	command.Flags().BoolVarP(&verbose, "verbose", "v", false, "Log each resource action call made to the server")
+	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh the application before listing the actions, so that they reflect the current state of the resources in the cluster")
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Hard refresh the application before listing the actions, which also regenerates its manifests")
+	command.Flags().BoolVar(&explain, "explain", false, "Print to stderr why each managed resource was or wasn't selected")
+	command.Flags().StringVar(&diffAgainst, "diff-against", "", "Path to the json output of a previous 'app actions list -o json'. Prints the actions which were added or removed since instead of the table")
+	command.Flags().BoolVar(&failOnDiff, "fail-on-diff", false, "Exit with a non-zero code if --diff-against finds differences")
+	command.Flags().BoolVar(&noSummary, "no-summary", false, "Do not print the summary of resources and actions to stderr after the table output")
+	command.Flags().StringVar(&columns, "columns", strings.Join(defaultResourceActionColumns, ","), fmt.Sprintf("Comma separated columns of the table output. Available columns: %s", strings.Join(resourceActionColumnNames, ", ")))
+	command.Flags().StringVar(&tee, "tee", "", "Also write the output to the given file, which is overwritten, while printing it to stdout")
+	command.Flags().BoolVar(&flow, "flow", false, "Print the yaml output in compact flow style on a single line, e.g. for embedding in logs")
+	command.Flags().BoolVar(&onlyDisabled, "only-disabled", false, "Only list actions which are defined but currently unavailable. Useful when debugging newly authored actions")
+	command.Flags().BoolVar(&resourceTree, "resource-tree", false, "Print the resources as a tree following their owner references, annotating each resource with its actions, instead of the table")
+	command.Flags().IntVar(&treeDepth, "depth", 0, "Maximum number of levels of the tree printed with --resource-tree. Unlimited if 0")
+	command.Flags().BoolVar(&showLabels, "show-labels", false, "Show the labels of each resource as the last column of the table output")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Keep listing the actions, redrawing the table whenever the resources or the availability of their actions change, until interrupted. Only supports the table output")
+	command.Flags().UintVar(&managedResourcesTimeout, "managed-resources-timeout", defaultManagedResourcesTimeoutSeconds, "Time out listing the managed resources of the application after this many seconds. Set to 0 to disable the timeout")
+	command.Flags().BoolVar(&explainRBAC, "explain-rbac", false, "Show whether the RBAC policy permits you to run each action, i.e. permitted or denied, as the last column of the table output. Nothing is run")
+	command.Flags().BoolVar(&includeConditions, "include-conditions", false, "Show the conditions under which each action is intended to be run, as declared by its discovery script, as the last column of the table output")
+	command.Flags().BoolVar(&showGroupAliases, "show-group-aliases", false, "Print the available group aliases and exit. Aliases can be added or overridden with 'group-aliases' in the CLI config")
+	command.Flags().StringVar(&templateFile, "output-template-file", "", "Path to a Go template file which is executed once for each action. Available fields: .Group, .Kind, .Namespace, .Name, .Action, .Available, .Conditions, .RBAC")
+
+	return command
+}
+
+// printResourceActionsSummary prints the number of inspected resources along with the number of resources with
+// actions, the total number of actions and the number of available actions
+func printResourceActionsSummary(w io.Writer, objs []*unstructured.Unstructured, availableActions map[string][]argoappv1.ResourceAction) {
+	withActions, total, available := 0, 0, 0
+	for _, obj := range objs {
+		actions := availableActions[resourceActionsKey(obj)]
+		if len(actions) > 0 {
+			withActions++
+		}
+		total += len(actions)
+		for _, action := range actions {
+			if action.Available {
+				available++
+			}
+		}
+	}
+	fmt.Fprintf(w, "\n%d resources inspected, %d with at least one action, %d total actions, %d available\n", len(objs), withActions, total, available)
+}
+
+// printResourceActionsTree prints the objects as a tree following their owner references, annotating each object
+// with its actions. Objects whose owner is not one of the given objects are roots. A depth greater than zero limits
+// the number of printed levels of the tree.
+func printResourceActionsTree(w io.Writer, objs []*unstructured.Unstructured, availableActions map[string][]argoappv1.ResourceAction, depth int) {
+	byUID := make(map[string]*unstructured.Unstructured)
+	for _, obj := range objs {
+		if uid := string(obj.GetUID()); uid != "" {
+			byUID[uid] = obj
+		}
+	}
+	children := make(map[string][]*unstructured.Unstructured)
+	var roots []*unstructured.Unstructured
+	for _, obj := range objs {
+		var parent *unstructured.Unstructured
+		for _, ref := range obj.GetOwnerReferences() {
+			if owner, ok := byUID[string(ref.UID)]; ok && owner != obj {
+				parent = owner
+				break
+			}
+		}
+		if parent == nil {
+			roots = append(roots, obj)
+		} else {
+			children[string(parent.GetUID())] = append(children[string(parent.GetUID())], obj)
+		}
+	}
+	label := func(obj *unstructured.Unstructured) string {
+		name := obj.GetName()
+		if obj.GetNamespace() != "" {
+			name = obj.GetNamespace() + "/" + name
+		}
+		var actions []string
+		for _, action := range availableActions[resourceActionsKey(obj)] {
+			if action.Available {
+				actions = append(actions, action.Name)
+			} else {
+				actions = append(actions, action.Name+" (unavailable)")
+			}
+		}
+		if len(actions) == 0 {
+			return fmt.Sprintf("%s %s", obj.GetKind(), name)
+		}
+		return fmt.Sprintf("%s %s: %s", obj.GetKind(), name, strings.Join(actions, ", "))
+	}
+	var printNode func(obj *unstructured.Unstructured, prefix string, last bool, level int)
+	printNode = func(obj *unstructured.Unstructured, prefix string, last bool, level int) {
+		childPrefix := prefix
+		if level == 1 {
+			fmt.Fprintln(w, label(obj))
+		} else if last {
+			fmt.Fprintf(w, "%s└── %s\n", prefix, label(obj))
+			childPrefix += "    "
+		} else {
+			fmt.Fprintf(w, "%s├── %s\n", prefix, label(obj))
+			childPrefix += "│   "
+		}
+		if depth > 0 && level >= depth {
+			return
+		}
+		nodeChildren := children[string(obj.GetUID())]
+		for i, child := range nodeChildren {
+			printNode(child, childPrefix, i == len(nodeChildren)-1, level+1)
+		}
+	}
+	for _, root := range roots {
+		printNode(root, "", true, 1)
+	}
+}
+
+// printResourceActionsCompact prints a single line per object, listing all of its actions followed by the available
+// ones, e.g. apps/Deployment/default/guestbook: restart,pause (available: restart)
+func printResourceActionsCompact(w io.Writer, objs []*unstructured.Unstructured, availableActions map[string][]argoappv1.ResourceAction) {
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		var parts []string
+		for _, part := range []string{gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName()} {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		var names []string
+		var available []string
+		for _, action := range availableActions[resourceActionsKey(obj)] {
+			names = append(names, action.Name)
+			if action.Available {
+				available = append(available, action.Name)
+			}
+		}
+		if len(names) == 0 {
+			names = []string{"<none>"}
+		}
+		if len(available) == 0 {
+			available = []string{"<none>"}
+		}
+		fmt.Fprintf(w, "%s: %s (available: %s)\n", strings.Join(parts, "/"), strings.Join(names, ","), strings.Join(available, ","))
+	}
+}
+
+const jsonPathOutputPrefix = "jsonpath="
+
+// printResourceActionsAs prints the available actions in the given structured output format. An error is returned for
+// any other format, so that a typo never silently results in no output.
+func printResourceActionsAs(w io.Writer, output string, flow bool, objs []*unstructured.Unstructured, availableActions map[string][]argoappv1.ResourceAction) error {
+	switch output {
+	case "yaml":
+		var yamlBytes []byte
+		var err error
+		if flow {
+			yamlBytes, err = marshalFlowYAML(availableActions)
+		} else {
+			yamlBytes, err = yaml.Marshal(availableActions)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(yamlBytes))
+		return err
+	case "json":
+		jsonBytes, err := json.MarshalIndent(availableActions, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(jsonBytes))
+		return err
+	case "compact":
+		printResourceActionsCompact(w, objs, availableActions)
+		return nil
+	default:
+		return unsupportedListOutputError(output)
+	}
+}
+
+// listOutputFormats are the output formats of the list command, besides jsonpath=EXPRESSION
+var listOutputFormats = []string{"table", "wide", "yaml", "json", "compact"}
+
+// unsupportedListOutputError returns the error for an output format which the list command does not support
+func unsupportedListOutputError(output string) error {
+	return fmt.Errorf("Unsupported output format '%s'. One of: %s, %sEXPRESSION", output, strings.Join(listOutputFormats, ", "), jsonPathOutputPrefix)
+}
+
+// validateListOutput returns an error unless output is one of the output formats of the list command
+func validateListOutput(output string) error {
+	if containsString(listOutputFormats, output) || strings.HasPrefix(output, jsonPathOutputPrefix) {
+		return nil
+	}
+	return unsupportedListOutputError(output)
+}
+
+// parseJSONPathOutput parses the expression of a jsonpath=EXPRESSION output format. As with kubectl, the enclosing
+// braces of the expression may be omitted.
+func parseJSONPathOutput(output string) (*jsonpath.JSONPath, error) {
+	expr := strings.TrimPrefix(output, jsonPathOutputPrefix)
+	if !strings.Contains(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+	jp := jsonpath.New("out")
+	if err := jp.Parse(expr); err != nil {
+		return nil, fmt.Errorf("Invalid JSONPath expression '%s': %v", expr, err)
+	}
+	return jp, nil
+}
+
+// printJSONPath executes the JSONPath expression against the JSON representation of obj
+func printJSONPath(w io.Writer, jp *jsonpath.JSONPath, obj interface{}) error {
+	jsonBytes, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var data interface{}
+	if err := json.Unmarshal(jsonBytes, &data); err != nil {
+		return err
+	}
+	return jp.Execute(w, data)
+}
+
+// marshalFlowYAML returns the YAML representation of obj in flow style, e.g. {key: [{name: restart}]}. As with
+// yaml.Marshal, obj is first converted to JSON so that its json tags apply.
+func marshalFlowYAML(obj interface{}) ([]byte, error) {
+	jsonBytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(jsonBytes)))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	var out strings.Builder
+	if err := writeFlowYAML(&out, data); err != nil {
+		return nil, err
+	}
+	return []byte(out.String()), nil
+}
+
+// writeFlowYAML writes the flow style YAML representation of the decoded JSON value
+func writeFlowYAML(w *strings.Builder, value interface{}) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		var keys []string
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		w.WriteString("{")
+		for i, key := range keys {
+			if i > 0 {
+				w.WriteString(", ")
+			}
+			if err := writeFlowYAML(w, key); err != nil {
+				return err
+			}
+			w.WriteString(": ")
+			if err := writeFlowYAML(w, v[key]); err != nil {
+				return err
+			}
+		}
+		w.WriteString("}")
+	case []interface{}:
+		w.WriteString("[")
+		for i, item := range v {
+			if i > 0 {
+				w.WriteString(", ")
+			}
+			if err := writeFlowYAML(w, item); err != nil {
+				return err
+			}
+		}
+		w.WriteString("]")
+	case string:
+		// let the yaml encoder decide whether the string needs quoting, but fall back to a JSON string, which is
+		// also a valid YAML scalar, if it would be emitted as a block or contains flow indicators
+		scalar, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		plain := strings.TrimSuffix(string(scalar), "\n")
+		if strings.ContainsAny(plain, "\n{}[],") {
+			quoted, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			plain = string(quoted)
+		}
+		w.WriteString(plain)
+	case nil:
+		w.WriteString("null")
+	default:
+		w.WriteString(fmt.Sprintf("%v", v))
+	}
+	return nil
+}
+
+// resourceActionChange is an action which was added to or removed from a resource
+type resourceActionChange struct {
+	Added     bool
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+	Action    string
+}
+
+// readResourceActionsFile reads the available actions, keyed by resourceActionsKey, from the json output of the
+// list command
+func readResourceActionsFile(path string) (map[string][]argoappv1.ResourceAction, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	actions := make(map[string][]argoappv1.ResourceAction)
+	if err := json.Unmarshal(data, &actions); err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+// diffResourceActions returns the actions which were added or removed in current compared to baseline, sorted
+// by resource and action
+func diffResourceActions(baseline, current map[string][]argoappv1.ResourceAction) []resourceActionChange {
+	var changes []resourceActionChange
+	appendChanges := func(from, to map[string][]argoappv1.ResourceAction, added bool) {
+		for key, actions := range from {
+			names := make(map[string]bool)
+			for _, action := range to[key] {
+				names[action.Name] = true
+			}
+			parts := strings.SplitN(key, "\t", 4)
+			if len(parts) != 4 {
+				continue
+			}
+			for _, action := range actions {
+				if !names[action.Name] {
+					changes = append(changes, resourceActionChange{Added: added, Group: parts[0], Kind: parts[1], Namespace: parts[2], Name: parts[3], Action: action.Name})
+				}
+			}
+		}
+	}
+	appendChanges(current, baseline, true)
+	appendChanges(baseline, current, false)
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Group+"/"+a.Kind != b.Group+"/"+b.Kind {
+			return a.Group+"/"+a.Kind < b.Group+"/"+b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Action < b.Action
+	})
+	return changes
+}
+
+// printResourceActionChanges prints the added and removed actions as a table
+func printResourceActionChanges(w io.Writer, changes []resourceActionChange) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "CHANGE\tGROUP\tKIND\tNAMESPACE\tNAME\tACTION\n")
+	for _, change := range changes {
+		changeType := "removed"
+		if change.Added {
+			changeType = "added"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", changeType, change.Group, change.Kind, change.Namespace, change.Name, change.Action)
+	}
+	_ = tw.Flush()
+}
+
+// listResourceActions returns the actions available for each of the objects, keyed by resourceActionsKey, along
+// with one row per action in the order of the given objects. The actions of all of the objects are listed with a
+// single call, unless the server does not support it.
+func listResourceActions(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName string, objs []*unstructured.Unstructured) (map[string][]argoappv1.ResourceAction, []resourceActionRow, error) {
+	req := applicationpkg.ResourceActionsListBatchRequest{Name: &appName}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		req.Targets = append(req.Targets, applicationpkg.ResourceActionTarget{
+			Namespace:    obj.GetNamespace(),
+			ResourceName: obj.GetName(),
+			Version:      gvk.Version,
+			Group:        gvk.Group,
+			Kind:         gvk.Kind,
+		})
+	}
+	res, err := appIf.ListResourceActionsBatch(ctx, &req)
+	if status.Code(err) == codes.Unimplemented {
+		// servers older than v1.3.0 can only list the actions of one resource per call
+		return listResourceActionsEach(ctx, appIf, appName, objs)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	targetActions := make(map[string][]argoappv1.ResourceAction)
+	deleted := make(map[string]bool)
+	for _, result := range res.Results {
+		target := result.Target
+		key := target.Group + "/" + target.Kind + "/" + target.Namespace + "/" + target.ResourceName
+		if result.Error != "" {
+			if result.Reason != applicationpkg.ResourceActionFailureReason_NotFound {
+				return nil, nil, fmt.Errorf("Failed to list the actions of %s %s/%s: %s", target.Kind, target.Namespace, target.ResourceName, result.Error)
+			}
+			log.Warnf("Skipping %s %s/%s, which no longer exists: %s", target.Kind, target.Namespace, target.ResourceName, result.Error)
+			deleted[key] = true
+			continue
+		}
+		targetActions[key] = result.Actions
+	}
+	availableActions := make(map[string][]argoappv1.ResourceAction)
+	var rows []resourceActionRow
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		key := gvk.Group + "/" + gvk.Kind + "/" + obj.GetNamespace() + "/" + obj.GetName()
+		if deleted[key] {
+			continue
+		}
+		actions := targetActions[key]
+		availableActions[resourceActionsKey(obj)] = actions
+		rows = appendResourceActionRows(rows, obj, actions)
+	}
+	return availableActions, rows, nil
+}
+
+// listResourceActionsEach lists the actions of the objects with one call per object
+func listResourceActionsEach(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName string, objs []*unstructured.Unstructured) (map[string][]argoappv1.ResourceAction, []resourceActionRow, error) {
+	availableActions := make(map[string][]argoappv1.ResourceAction)
+	var rows []resourceActionRow
+	// the kinds which the server reported to have no actions at all, whose other resources need not be queried
+	noActionKinds := make(map[schema.GroupKind]bool)
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		if noActionKinds[gvk.GroupKind()] {
+			availableActions[resourceActionsKey(obj)] = nil
+			continue
+		}
+		availActionsForResource, err := appIf.ListResourceActions(ctx, &applicationpkg.ApplicationResourceRequest{
+			Name:         &appName,
+			Namespace:    obj.GetNamespace(),
+			ResourceName: obj.GetName(),
+			Group:        gvk.Group,
+			Kind:         gvk.Kind,
+		})
+		if applicationpkg.ActionFailureReason(err) == applicationpkg.ResourceActionFailureReason_NotFound {
+			log.Warnf("Skipping %s %s/%s, which no longer exists: %s", gvk.Kind, obj.GetNamespace(), obj.GetName(), status.Convert(err).Message())
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if availActionsForResource.NoActionsForKind {
+			noActionKinds[gvk.GroupKind()] = true
+		}
+		availableActions[resourceActionsKey(obj)] = availActionsForResource.Actions
+		rows = appendResourceActionRows(rows, obj, availActionsForResource.Actions)
+	}
+	return availableActions, rows, nil
+}
+
+// appendResourceActionRows appends a row for each of the actions of the object
+func appendResourceActionRows(rows []resourceActionRow, obj *unstructured.Unstructured, actions []argoappv1.ResourceAction) []resourceActionRow {
+	gvk := obj.GroupVersionKind()
+	for _, action := range actions {
+		rows = append(rows, resourceActionRow{
+			Group:      gvk.Group,
+			Kind:       gvk.Kind,
+			Scope:      resourceScope(gvk.GroupKind(), obj.GetNamespace()),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+			Action:     action.Name,
+			Available:  action.Available,
+			Labels:     obj.GetLabels(),
+			Conditions: action.Conditions,
+			Fields:     kindColumnValues(obj),
+		})
+	}
+	return rows
+}
+
+// unavailableResourceActions returns only the actions and rows of actions which are not available
+func unavailableResourceActions(availableActions map[string][]argoappv1.ResourceAction, rows []resourceActionRow) (map[string][]argoappv1.ResourceAction, []resourceActionRow) {
+	unavailable := make(map[string][]argoappv1.ResourceAction)
+	for key, actions := range availableActions {
+		for _, action := range actions {
+			if !action.Available {
+				unavailable[key] = append(unavailable[key], action)
+			}
+		}
+	}
+	var unavailableRows []resourceActionRow
+	for _, row := range rows {
+		if !row.Available {
+			unavailableRows = append(unavailableRows, row)
+		}
+	}
+	return unavailable, unavailableRows
+}
@@ -0,0 +1,461 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	accountpkg "github.com/argoproj/argo-cd/pkg/apiclient/account"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestParseTemplateFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "actions-template")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	t.Run("Valid", func(t *testing.T) {
+		path := filepath.Join(dir, "valid.tmpl")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("{{.Kind}}/{{.Name}}: {{.Action}}"), 0644))
+		tmpl, err := parseTemplateFile(path)
+		assert.NoError(t, err)
+		var out bytes.Buffer
+		assert.NoError(t, tmpl.Execute(&out, resourceActionRow{Kind: "Deployment", Name: "guestbook", Action: "restart"}))
+		assert.Equal(t, "Deployment/guestbook: restart", out.String())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.tmpl")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("{{.Kind"), 0644))
+		_, err := parseTemplateFile(path)
+		assert.Error(t, err)
+	})
+	t.Run("Missing", func(t *testing.T) {
+		_, err := parseTemplateFile(filepath.Join(dir, "missing.tmpl"))
+		assert.Error(t, err)
+	})
+}
+
+func TestListResourceActionsSameNameInDifferentNamespaces(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("dev", "guestbook"), newDeployment("prod", "guestbook")}
+	availableActions, rows, err := listResourceActions(context.Background(), &fakeAppServiceClient{}, "guestbook", objs)
+	assert.NoError(t, err)
+
+	assert.Len(t, availableActions, 2)
+	assert.Equal(t, "dev-only", availableActions["apps\tDeployment\tdev\tguestbook"][1].Name)
+	assert.Equal(t, "prod-only", availableActions["apps\tDeployment\tprod\tguestbook"][1].Name)
+	if assert.Len(t, rows, 4) {
+		assert.Equal(t, "dev", rows[0].Namespace)
+		assert.Equal(t, "prod", rows[2].Namespace)
+	}
+}
+
+func TestPrintResourceActionsTree(t *testing.T) {
+	newOwned := func(kind, name, uid string, owners ...string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("argoproj.io/v1alpha1")
+		obj.SetKind(kind)
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		obj.SetUID(types.UID(uid))
+		var refs []metav1.OwnerReference
+		for _, owner := range owners {
+			refs = append(refs, metav1.OwnerReference{UID: types.UID(owner)})
+		}
+		obj.SetOwnerReferences(refs)
+		return obj
+	}
+	objs := []*unstructured.Unstructured{
+		newOwned("CronWorkflow", "nightly", "1"),
+		newOwned("Workflow", "nightly-1", "2", "1"),
+		newOwned("Workflow", "nightly-2", "3", "1"),
+		newOwned("Pod", "nightly-2-pod", "4", "3"),
+		newOwned("Rollout", "guestbook", "5", "unmanaged"),
+	}
+	availableActions := map[string][]argoappv1.ResourceAction{
+		resourceActionsKey(objs[0]): {{Name: "suspend", Available: true}},
+		resourceActionsKey(objs[2]): {{Name: "resubmit", Available: true}, {Name: "stop", Available: false}},
+		resourceActionsKey(objs[4]): {{Name: "resume", Available: true}},
+	}
+
+	var buf bytes.Buffer
+	printResourceActionsTree(&buf, objs, availableActions, 0)
+	assert.Equal(t, `CronWorkflow default/nightly: suspend
+├── Workflow default/nightly-1
+└── Workflow default/nightly-2: resubmit, stop (unavailable)
+    └── Pod default/nightly-2-pod
+Rollout default/guestbook: resume
+`, buf.String())
+
+	buf.Reset()
+	printResourceActionsTree(&buf, objs, availableActions, 2)
+	assert.Equal(t, `CronWorkflow default/nightly: suspend
+├── Workflow default/nightly-1
+└── Workflow default/nightly-2: resubmit, stop (unavailable)
+Rollout default/guestbook: resume
+`, buf.String())
+}
+
+func TestUnavailableResourceActions(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "guestbook"), newDeployment("prod", "guestbook")}
+	availableActions, rows, err := listResourceActions(context.Background(), &fakeAppServiceClient{}, "guestbook", objs)
+	assert.NoError(t, err)
+
+	unavailable, unavailableRows := unavailableResourceActions(availableActions, rows)
+	assert.Equal(t, map[string][]argoappv1.ResourceAction{
+		resourceActionsKey(objs[0]): {{Name: "default-only", Available: false}},
+		resourceActionsKey(objs[1]): {{Name: "prod-only", Available: false}},
+	}, unavailable)
+	if assert.Len(t, unavailableRows, 2) {
+		assert.Equal(t, "default-only", unavailableRows[0].Action)
+		assert.Equal(t, "prod-only", unavailableRows[1].Action)
+	}
+}
+
+func TestPrintResourceActionsCompact(t *testing.T) {
+	service := &unstructured.Unstructured{}
+	service.SetAPIVersion("v1")
+	service.SetKind("Service")
+	service.SetNamespace("default")
+	service.SetName("guestbook")
+	objs := []*unstructured.Unstructured{newDeployment("default", "guestbook"), newDeployment("prod", "guestbook"), service}
+	availableActions := map[string][]argoappv1.ResourceAction{
+		resourceActionsKey(objs[0]): {{Name: "restart", Available: true}, {Name: "pause", Available: false}},
+		resourceActionsKey(objs[1]): {{Name: "pause", Available: false}},
+	}
+	var buf bytes.Buffer
+	printResourceActionsCompact(&buf, objs, availableActions)
+	assert.Equal(t, `apps/Deployment/default/guestbook: restart,pause (available: restart)
+apps/Deployment/prod/guestbook: pause (available: <none>)
+Service/default/guestbook: <none> (available: <none>)
+`, buf.String())
+}
+
+func TestPrintResourceActionsSummary(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "guestbook"), newDeployment("prod", "guestbook"), newDeployment("default", "no-actions")}
+	availableActions := map[string][]argoappv1.ResourceAction{
+		resourceActionsKey(objs[0]): {{Name: "restart", Available: true}, {Name: "pause", Available: false}},
+		resourceActionsKey(objs[1]): {{Name: "restart", Available: true}},
+	}
+	var buf bytes.Buffer
+	printResourceActionsSummary(&buf, objs, availableActions)
+	assert.Equal(t, "\n3 resources inspected, 2 with at least one action, 3 total actions, 2 available\n", buf.String())
+}
+
+func TestJSONPathOutput(t *testing.T) {
+	availableActions := map[string][]argoappv1.ResourceAction{
+		resourceActionsKey(newDeployment("default", "guestbook")): {{Name: "restart", Available: true}, {Name: "pause", Available: false}},
+	}
+	for _, output := range []string{"jsonpath={.*[*].name}", "jsonpath=.*[*].name"} {
+		jp, err := parseJSONPathOutput(output)
+		assert.NoError(t, err)
+		var buf bytes.Buffer
+		assert.NoError(t, printJSONPath(&buf, jp, availableActions))
+		assert.Equal(t, "restart pause", buf.String())
+	}
+
+	_, err := parseJSONPathOutput("jsonpath={.items[}")
+	assert.Error(t, err)
+}
+
+func TestValidateListOutput(t *testing.T) {
+	for _, output := range []string{"table", "wide", "yaml", "json", "compact", "jsonpath={.*[*].name}"} {
+		assert.NoError(t, validateListOutput(output), output)
+	}
+	for _, output := range []string{"xml", "Wide", "Table"} {
+		assert.EqualError(t, validateListOutput(output), fmt.Sprintf("Unsupported output format '%s'. One of: table, wide, yaml, json, compact, jsonpath=EXPRESSION", output))
+	}
+}
+
+func TestPrintResourceActionsAs(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "guestbook")}
+	availableActions := map[string][]argoappv1.ResourceAction{
+		resourceActionsKey(objs[0]): {{Name: "restart", Available: true}},
+	}
+	for _, output := range []string{"yaml", "json", "compact"} {
+		var buf bytes.Buffer
+		assert.NoError(t, printResourceActionsAs(&buf, output, false, objs, availableActions), output)
+		assert.Contains(t, buf.String(), "restart", output)
+	}
+
+	var buf bytes.Buffer
+	err := printResourceActionsAs(&buf, "xml", false, objs, availableActions)
+	assert.EqualError(t, err, "Unsupported output format 'xml'. One of: table, wide, yaml, json, compact, jsonpath=EXPRESSION")
+	assert.Empty(t, buf.String())
+}
+
+func TestDiffResourceActions(t *testing.T) {
+	guestbook := resourceActionsKey(newDeployment("default", "guestbook"))
+	removed := resourceActionsKey(newDeployment("default", "removed"))
+	baseline := map[string][]argoappv1.ResourceAction{
+		guestbook: {{Name: "restart"}, {Name: "pause"}},
+		removed:   {{Name: "restart"}},
+	}
+	current := map[string][]argoappv1.ResourceAction{
+		guestbook: {{Name: "restart"}, {Name: "resume"}},
+	}
+
+	dir, err := ioutil.TempDir("", "actions-baseline")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	baselinePath := filepath.Join(dir, "baseline.json")
+	data, err := json.Marshal(baseline)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(baselinePath, data, 0644))
+	loaded, err := readResourceActionsFile(baselinePath)
+	assert.NoError(t, err)
+
+	changes := diffResourceActions(loaded, current)
+	assert.Equal(t, []resourceActionChange{
+		{Added: false, Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Action: "pause"},
+		{Added: true, Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Action: "resume"},
+		{Added: false, Group: "apps", Kind: "Deployment", Namespace: "default", Name: "removed", Action: "restart"},
+	}, changes)
+	assert.Empty(t, diffResourceActions(current, current))
+}
+
+func TestPrintResourceActionsTableColumns(t *testing.T) {
+	rows := []resourceActionRow{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Action: "restart", Available: true}}
+
+	columns, err := parseColumns("namespace, Name,action")
+	assert.NoError(t, err)
+	var buf bytes.Buffer
+	printResourceActionsTable(&buf, rows, columns)
+	assert.Equal(t, "\nNAMESPACE  NAME       ACTION\ndefault    guestbook  restart\n", buf.String())
+
+	_, err = parseColumns("name,status")
+	assert.EqualError(t, err, "Unknown column 'status'. Available columns: group, kind, scope, namespace, name, action, available, labels, conditions, rbac")
+	_, err = parseColumns("")
+	assert.Error(t, err)
+}
+
+func TestPrintResourceActionsTableConditions(t *testing.T) {
+	rows := []resourceActionRow{
+		{Kind: "Rollout", Name: "guestbook", Action: "resume", Conditions: []string{"Paused", "Degraded"}},
+		{Kind: "Rollout", Name: "guestbook", Action: "restart"},
+	}
+	var buf bytes.Buffer
+	printResourceActionsTable(&buf, rows, []string{"kind", "name", "action", "conditions"})
+	assert.Equal(t, "\nKIND     NAME       ACTION   CONDITIONS\nRollout  guestbook  resume   Paused,Degraded\nRollout  guestbook  restart  \n", buf.String())
+}
+
+// fakeAccountServiceClient is an account service client permitting the actions in permitted
+type fakeAccountServiceClient struct {
+	accountpkg.AccountServiceClient
+	permitted map[string]bool
+	requests  []accountpkg.CanIRequest
+}
+
+func (c *fakeAccountServiceClient) CanI(_ context.Context, in *accountpkg.CanIRequest, _ ...grpc.CallOption) (*accountpkg.CanIResponse, error) {
+	c.requests = append(c.requests, *in)
+	if c.permitted[in.Action] {
+		return &accountpkg.CanIResponse{Value: "yes"}, nil
+	}
+	return &accountpkg.CanIResponse{Value: "no"}, nil
+}
+
+func TestExplainResourceActionsRBAC(t *testing.T) {
+	accountIf := &fakeAccountServiceClient{permitted: map[string]bool{"action/apps/Deployment/restart": true}}
+	app := &argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook"}}
+	rows := []resourceActionRow{
+		{Group: "apps", Kind: "Deployment", Name: "guestbook-ui", Action: "restart"},
+		{Group: "apps", Kind: "Deployment", Name: "guestbook-redis", Action: "restart"},
+		{Group: "argoproj.io", Kind: "Rollout", Name: "guestbook", Action: "resume"},
+	}
+	assert.NoError(t, explainResourceActionsRBAC(context.Background(), accountIf, app, rows))
+	assert.Equal(t, "permitted", rows[0].RBAC)
+	assert.Equal(t, "permitted", rows[1].RBAC)
+	assert.Equal(t, "denied", rows[2].RBAC)
+	// the server is asked once per action, within the project of the application
+	assert.Equal(t, []accountpkg.CanIRequest{
+		{Resource: "applications", Action: "action/apps/Deployment/restart", Subresource: "default/guestbook"},
+		{Resource: "applications", Action: "action/argoproj.io/Rollout/resume", Subresource: "default/guestbook"},
+	}, accountIf.requests)
+}
+
+func TestWideKindColumns(t *testing.T) {
+	deployment := newDeployment("default", "web")
+	assert.NoError(t, unstructured.SetNestedField(deployment.Object, int64(3), "spec", "replicas"))
+	assert.NoError(t, unstructured.SetNestedField(deployment.Object, int64(2), "status", "readyReplicas"))
+	deployment.SetAnnotations(map[string]string{"deployment.kubernetes.io/revision": "7"})
+	assert.Equal(t, map[string]string{"replicas": "3", "ready": "2", "revision": "7"}, kindColumnValues(deployment))
+	assert.Nil(t, kindColumnValues(newConfigMap("default", "config")))
+
+	rows := []resourceActionRow{
+		{Group: "", Kind: "ConfigMap", Scope: scopeNamespaced, Namespace: "default", Name: "config", Action: "reload"},
+		{Group: "apps", Kind: "Deployment", Scope: scopeNamespaced, Namespace: "default", Name: "web", Action: "restart", Available: true, Fields: kindColumnValues(deployment)},
+		{Group: "batch", Kind: "CronJob", Scope: scopeNamespaced, Namespace: "default", Name: "cleanup", Action: "trigger", Available: true, Fields: map[string]string{"schedule": "@daily"}},
+		{Group: "apps", Kind: "StatefulSet", Scope: scopeNamespaced, Namespace: "default", Name: "db", Action: "restart", Fields: map[string]string{"replicas": "1"}},
+		{Group: "argoproj.io", Kind: "ClusterWorkflowTemplate", Scope: scopeCluster, Name: "ci", Action: "submit", Available: true},
+	}
+	columns := withKindColumns(wideResourceActionColumns, rows)
+	// columns shared by kinds are only added once, and the base columns are not modified
+	assert.Equal(t, []string{"group", "kind", "scope", "namespace", "name", "action", "available", "conditions", "replicas", "ready", "up-to-date", "revision", "schedule", "suspend"}, columns)
+	assert.Len(t, wideResourceActionColumns, 8)
+
+	var out bytes.Buffer
+	printResourceActionsTable(&out, rows, columns)
+	var lines []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+	assert.Equal(t, []string{
+		"",
+		"GROUP        KIND                     SCOPE       NAMESPACE  NAME     ACTION   AVAILABLE  CONDITIONS  REPLICAS  READY  UP-TO-DATE  REVISION  SCHEDULE  SUSPEND",
+		"             ConfigMap                Namespaced  default    config   reload   false",
+		"apps         Deployment               Namespaced  default    web      restart  true                   3         2                  7",
+		"batch        CronJob                  Namespaced  default    cleanup  trigger  true                                                          @daily",
+		"apps         StatefulSet              Namespaced  default    db       restart  false                  1",
+		"argoproj.io  ClusterWorkflowTemplate  Cluster                ci       submit   true",
+		"",
+	}, lines)
+}
+
+func TestMarshalFlowYAML(t *testing.T) {
+	availableActions := map[string][]argoappv1.ResourceAction{
+		"apps\tDeployment\tdefault\tguestbook": {{Name: "restart", Available: true}, {Name: "scale"}},
+		"batch\tCronJob\tdefault\tcleanup":     {},
+	}
+	yamlBytes, err := marshalFlowYAML(availableActions)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"apps\tDeployment\tdefault\tguestbook": [{available: true, name: restart}, {name: scale}], "batch\tCronJob\tdefault\tcleanup": []}`, string(yamlBytes))
+
+	// strings which are not plain scalars are quoted
+	yamlBytes, err = marshalFlowYAML([]string{"true", "a,b", "multi\nline", "{x}"})
+	assert.NoError(t, err)
+	assert.Equal(t, `["true", "a,b", "multi\nline", "{x}"]`, string(yamlBytes))
+}
+
+func TestListResourceActionsSkipsKindsWithoutActions(t *testing.T) {
+	appIf := &fakeAppServiceClient{}
+	var objs []*unstructured.Unstructured
+	for i := 0; i < 10; i++ {
+		objs = append(objs, newConfigMap("default", fmt.Sprintf("config-%d", i)), newDeployment("default", fmt.Sprintf("guestbook-%d", i)))
+	}
+	availableActions, rows, err := listResourceActions(context.Background(), appIf, "guestbook", objs)
+	assert.NoError(t, err)
+
+	// the config maps are only queried once, while each deployment is queried for the availability of its actions
+	assert.Equal(t, 11, appIf.listCalls)
+	assert.Len(t, availableActions, 20)
+	assert.Empty(t, availableActions[resourceActionsKey(newConfigMap("default", "config-9"))])
+	assert.Len(t, availableActions[resourceActionsKey(newDeployment("default", "guestbook-9"))], 2)
+	assert.Len(t, rows, 20)
+}
+
+func TestListResourceActionsBatch(t *testing.T) {
+	appIf := &fakeAppServiceClient{listBatch: true}
+	var objs []*unstructured.Unstructured
+	for i := 0; i < 10; i++ {
+		objs = append(objs, newConfigMap("default", fmt.Sprintf("config-%d", i)), newDeployment("default", fmt.Sprintf("guestbook-%d", i)))
+	}
+	availableActions, rows, err := listResourceActions(context.Background(), appIf, "guestbook", objs)
+	assert.NoError(t, err)
+
+	// the actions of all of the resources are listed with a single call
+	assert.Equal(t, 1, appIf.listBatchCalls)
+	assert.Len(t, availableActions, 20)
+	assert.Empty(t, availableActions[resourceActionsKey(newConfigMap("default", "config-9"))])
+	assert.Len(t, availableActions[resourceActionsKey(newDeployment("default", "guestbook-9"))], 2)
+	if assert.Len(t, rows, 20) {
+		assert.Equal(t, resourceActionRow{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-0", Action: "restart", Available: true, Scope: scopeNamespaced, Fields: map[string]string{}}, rows[0])
+	}
+
+	// older servers are called once per resource instead
+	appIf = &fakeAppServiceClient{}
+	_, fallbackRows, err := listResourceActions(context.Background(), appIf, "guestbook", objs)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, appIf.listBatchCalls)
+	assert.Equal(t, 11, appIf.listCalls)
+	assert.Equal(t, rows, fallbackRows)
+
+	// resources deleted in the meantime are left out, while any other failure to list the actions of a resource fails
+	withDeleted := append([]*unstructured.Unstructured{newDeployment("default", "deleted")}, objs...)
+	for _, listBatch := range []bool{true, false} {
+		appIf = &fakeAppServiceClient{listBatch: listBatch}
+		availableActions, deletedRows, err := listResourceActions(context.Background(), appIf, "guestbook", withDeleted)
+		assert.NoError(t, err)
+		assert.Len(t, availableActions, 20)
+		assert.Equal(t, rows, deletedRows)
+
+		_, _, err = listResourceActions(context.Background(), appIf, "guestbook", append(withDeleted, newDeployment("default", "forbidden")))
+		assert.Error(t, err)
+	}
+}
+
+func TestWatchResourceActions(t *testing.T) {
+	newRow := func(name string, available bool) resourceActionRow {
+		return resourceActionRow{Kind: "Deployment", Namespace: "default", Name: name, Action: "restart", Available: available}
+	}
+	updates := [][]resourceActionRow{
+		{newRow("web", true)},
+		// unchanged, so the table is not printed again
+		{newRow("web", true)},
+		// no resource matches anymore, which is printed as an empty table
+		nil,
+		{newRow("web", false), newRow("api", false)},
+	}
+	events := make(chan *argoappv1.ApplicationWatchEvent)
+	listed := 0
+	var out bytes.Buffer
+	done := make(chan error)
+	go func() {
+		done <- watchResourceActions(context.Background(), &out, []resourceActionRow{newRow("web", false)}, events, func() ([]resourceActionRow, error) {
+			rows := updates[listed]
+			listed++
+			return rows, nil
+		}, []string{"name", "available"}, "<clear>", time.Millisecond)
+	}()
+	for range updates {
+		events <- &argoappv1.ApplicationWatchEvent{}
+		// let the update be listed before the next one is sent
+		time.Sleep(50 * time.Millisecond)
+	}
+	close(events)
+	assert.NoError(t, <-done)
+	assert.Equal(t, 4, listed)
+	assert.Equal(t, 4, strings.Count(out.String(), "<clear>"))
+	tables := strings.Split(out.String(), "<clear>")
+	assert.Contains(t, tables[1], "web")
+	assert.Contains(t, tables[2], "web   true")
+	assert.NotContains(t, tables[3], "web")
+	assert.Contains(t, tables[4], "api   false")
+
+	// the watch stops once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NoError(t, watchResourceActions(ctx, ioutil.Discard, nil, make(chan *argoappv1.ApplicationWatchEvent), nil, []string{"name"}, "", time.Millisecond))
+}
+
+func TestWatchedResources(t *testing.T) {
+	command := NewApplicationResourceActionsListCommand(&argocdclient.ClientOptions{})
+	resources := []*argoappv1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "web", LiveState: `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web", "namespace": "default"}}`},
+	}
+
+	objs, err := watchedResources(command, resources, "", []string{"Deployment"}, "", "", labels.Everything())
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+
+	// the resources disappeared, which is not an error while watching
+	objs, err = watchedResources(command, resources, "", []string{"StatefulSet"}, "", "", labels.Everything())
+	assert.NoError(t, err)
+	assert.Empty(t, objs)
+	objs, err = watchedResources(command, nil, "", nil, "", "", labels.Everything())
+	assert.NoError(t, err)
+	assert.Empty(t, objs)
+}
@@ -0,0 +1,548 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/yudai/gojsondiff"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/diff"
+)
+
+// printMultiAppActionResults prints the results of running an action across multiple applications as a table
+func printMultiAppActionResults(w io.Writer, results []actionResult) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "APP\tGROUP\tKIND\tNAMESPACE\tNAME\tACTION\tRESULT\n")
+	for _, result := range results {
+		outcome := "succeeded"
+		if result.Error != nil {
+			outcome = fmt.Sprintf("failed: %v", result.Error)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", result.App, result.Group, result.Kind, result.Namespace, result.Name, result.Action, outcome)
+	}
+	_ = tw.Flush()
+}
+
+// printActionResultsHealth prints the health of the resources the action was run on, as reported by the application
+func printActionResultsHealth(out io.Writer, app *argoappv1.Application, results []actionResult) {
+	health := make(map[string]*argoappv1.HealthStatus)
+	for _, res := range app.Status.Resources {
+		health[res.Group+"/"+res.Kind+"/"+res.Namespace+"/"+res.Name] = res.Health
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tHEALTH\tMESSAGE\n")
+	for _, result := range results {
+		status, message := argoappv1.HealthStatusUnknown, ""
+		if h := health[result.Group+"/"+result.Kind+"/"+result.Namespace+"/"+result.Name]; h != nil {
+			status, message = h.Status, h.Message
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", result.Group, result.Kind, result.Namespace, result.Name, status, message)
+	}
+	_ = w.Flush()
+}
+
+// actionResult is the outcome of running an action on a single resource
+type actionResult struct {
+	// App is the application of the resource. Only set when running an action across multiple applications.
+	App       string
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+	Action    string
+	Error     error
+	// Reason is the category of the failure reported by the server if the action failed. It is not printed if the
+	// failure falls into none of the categories.
+	Reason applicationpkg.ResourceActionFailureReason
+	// ResourceRevision and Generation describe the resource after the action succeeded. ResourceRevision is 0 if the
+	// controller of the resource records no revisions.
+	ResourceRevision int64
+	Generation       int64
+	// LiveState and TargetState are the JSON of the resource before the action and of the state it would result in.
+	// Only set for dry runs, except for TargetState, which is also set to the resource after the action if requested.
+	LiveState   string
+	TargetState string
+}
+
+// MarshalJSON renders the result with its error, if any, as a message
+func (r actionResult) MarshalJSON() ([]byte, error) {
+	out := struct {
+		App        string `json:"app,omitempty"`
+		Group      string `json:"group"`
+		Kind       string `json:"kind"`
+		Namespace  string `json:"namespace"`
+		Name       string `json:"name"`
+		Action     string `json:"action"`
+		Succeeded  bool   `json:"succeeded"`
+		Error      string `json:"error,omitempty"`
+		Reason     string `json:"reason,omitempty"`
+		Revision   int64  `json:"revision,omitempty"`
+		Generation int64  `json:"generation,omitempty"`
+	}{
+		App:        r.App,
+		Group:      r.Group,
+		Kind:       r.Kind,
+		Namespace:  r.Namespace,
+		Name:       r.Name,
+		Action:     r.Action,
+		Succeeded:  r.Error == nil,
+		Revision:   r.ResourceRevision,
+		Generation: r.Generation,
+	}
+	if r.Error != nil {
+		out.Error = r.Error.Error()
+	}
+	if r.Reason != applicationpkg.ResourceActionFailureReason_Unknown {
+		out.Reason = r.Reason.String()
+	}
+	return json.Marshal(out)
+}
+
+// printActionResultDiffs prints a diff of each resource the action succeeded on in a dry run against the state the
+// action would result in, using printDiff, and returns the number of resources the action would change. Resources
+// which would not change are not printed.
+func printActionResultDiffs(w io.Writer, results []actionResult, printDiff func(name string, live *unstructured.Unstructured, target *unstructured.Unstructured) error) (int, error) {
+	changed := 0
+	for _, result := range results {
+		if result.Error != nil {
+			continue
+		}
+		var live, target unstructured.Unstructured
+		if err := json.Unmarshal([]byte(result.LiveState), &live.Object); err != nil {
+			return changed, fmt.Errorf("Failed to parse the live state of %s %s/%s: %v", result.Kind, result.Namespace, result.Name, err)
+		}
+		if err := json.Unmarshal([]byte(result.TargetState), &target.Object); err != nil {
+			return changed, fmt.Errorf("Failed to parse the dry run state of %s %s/%s: %v", result.Kind, result.Namespace, result.Name, err)
+		}
+		if reflect.DeepEqual(live.Object, target.Object) {
+			continue
+		}
+		changed++
+		fmt.Fprintf(w, "===== %s/%s %s/%s ======\n", result.Group, result.Kind, result.Namespace, result.Name)
+		if err := printDiff(result.Name, &live, &target); err != nil {
+			// diff exits with 1 if the files differ
+			if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.ExitCode() != 1 {
+				return changed, err
+			}
+		}
+	}
+	return changed, nil
+}
+
+// newAppDiffNormalizer returns the normalizer the application is compared with its desired state with, which
+// honors the ignored differences of the application and of the resource customizations
+func newAppDiffNormalizer(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, acdClient argocdclient.Client, appName string) (diff.Normalizer, error) {
+	app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
+	if err != nil {
+		return nil, err
+	}
+	conn, settingsIf := acdClient.NewSettingsClientOrDie()
+	defer util.Close(conn)
+	argoSettings, err := settingsIf.Get(ctx, &settingspkg.SettingsQuery{})
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[string]argoappv1.ResourceOverride)
+	for k := range argoSettings.ResourceOverrides {
+		overrides[k] = *argoSettings.ResourceOverrides[k]
+	}
+	return argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, overrides)
+}
+
+// printActionResultDrift warns about each resource the dry run of an action would make diverge from its desired state
+// in git, and prints a diff of the state after the action against the state the next sync would revert it to.
+// Resources which are not in git, and those whose differences from git the action leaves as they are, are skipped.
+// Returns the number of resources the action would create drift on.
+func printActionResultDrift(w io.Writer, results []actionResult, resources []*argoappv1.ResourceDiff, normalizer diff.Normalizer, printDiff func(name string, live *unstructured.Unstructured, target *unstructured.Unstructured) error) (int, error) {
+	desired := make(map[string]*argoappv1.ResourceDiff)
+	for _, res := range resources {
+		desired[fmt.Sprintf("%s/%s/%s/%s", res.Group, res.Kind, res.Namespace, res.Name)] = res
+	}
+	drifted := 0
+	for _, result := range results {
+		if result.Error != nil {
+			continue
+		}
+		res, ok := desired[fmt.Sprintf("%s/%s/%s/%s", result.Group, result.Kind, result.Namespace, result.Name)]
+		if !ok || res.TargetState == "" || res.TargetState == "null" {
+			continue
+		}
+		target, err := res.TargetObject()
+		if err != nil {
+			return drifted, fmt.Errorf("Failed to parse the desired state of %s %s/%s: %v", result.Kind, result.Namespace, result.Name, err)
+		}
+		var live, simulated unstructured.Unstructured
+		if err := json.Unmarshal([]byte(result.LiveState), &live.Object); err != nil {
+			return drifted, fmt.Errorf("Failed to parse the live state of %s %s/%s: %v", result.Kind, result.Namespace, result.Name, err)
+		}
+		if err := json.Unmarshal([]byte(result.TargetState), &simulated.Object); err != nil {
+			return drifted, fmt.Errorf("Failed to parse the dry run state of %s %s/%s: %v", result.Kind, result.Namespace, result.Name, err)
+		}
+		after := diff.Diff(target, &simulated, normalizer)
+		if !after.Modified {
+			continue
+		}
+		// differences from git which the resource already has are not caused by the action
+		before := diff.Diff(target, &live, normalizer)
+		beforeDelta, err := before.JSONFormat()
+		if err != nil {
+			return drifted, err
+		}
+		afterDelta, err := after.JSONFormat()
+		if err != nil {
+			return drifted, err
+		}
+		if beforeDelta == afterDelta {
+			continue
+		}
+		drifted++
+		log.Warnf("This action creates drift: running %s on %s %s/%s makes it diverge from its desired state in git, which the next sync may revert", result.Action, result.Kind, result.Namespace, result.Name)
+		fmt.Fprintf(w, "===== drift %s/%s %s/%s ======\n", result.Group, result.Kind, result.Namespace, result.Name)
+		reverted := simulated.DeepCopy()
+		gojsondiff.New().ApplyPatch(reverted.Object, after.Diff)
+		if err := printDiff(result.Name, &simulated, reverted); err != nil {
+			// diff exits with 1 if the files differ
+			if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.ExitCode() != 1 {
+				return drifted, err
+			}
+		}
+	}
+	return drifted, nil
+}
+
+// printResultingObjects prints the resources the action succeeded on, as they are after the action, as multi-document
+// yaml. The managed fields of the resources are removed unless showManagedFields is set.
+func printResultingObjects(w io.Writer, results []actionResult, showManagedFields bool) error {
+	for _, result := range results {
+		if result.Error != nil || result.TargetState == "" {
+			continue
+		}
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal([]byte(result.TargetState), &obj.Object); err != nil {
+			return fmt.Errorf("Failed to parse the state of %s %s/%s after the action: %v", result.Kind, result.Namespace, result.Name, err)
+		}
+		if !showManagedFields {
+			unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+		}
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeSnapshot writes the manifests of the objects to the file at path as multi-document yaml, so that they can be
+// restored manually after the action ran. The managed fields of the objects are removed unless showManagedFields is
+// set.
+func writeSnapshot(path string, objs []*unstructured.Unstructured, showManagedFields bool) error {
+	var buf bytes.Buffer
+	for _, obj := range objs {
+		if !showManagedFields {
+			obj = obj.DeepCopy()
+			unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+		}
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(&buf, "---\n%s", data)
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), 0600)
+}
+
+// printActionResultsRevisions prints the revision each resource is at after the action succeeded on it, so that the
+// revision promoted to can be recorded. Resources whose controller records no revisions are not printed.
+func printActionResultsRevisions(w io.Writer, results []actionResult) {
+	for _, result := range results {
+		if result.Error != nil || result.ResourceRevision == 0 {
+			continue
+		}
+		resource := fmt.Sprintf("%s %s/%s", result.Kind, result.Namespace, result.Name)
+		if strings.HasPrefix(result.Action, "promote") {
+			fmt.Fprintf(w, "promoted %s to revision %d\n", resource, result.ResourceRevision)
+		} else {
+			fmt.Fprintf(w, "ran %s on %s, which is at revision %d\n", result.Action, resource, result.ResourceRevision)
+		}
+	}
+}
+
+// printActionResults prints all action results, including failures, in the given output format
+func printActionResults(w io.Writer, results []actionResult, output string) error {
+	if results == nil {
+		results = []actionResult{}
+	}
+	var data []byte
+	var err error
+	switch output {
+	case "yaml":
+		data, err = yaml.Marshal(results)
+	case "jsonl":
+		return printActionResultsJSONL(w, results)
+	default:
+		data, err = json.MarshalIndent(results, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// jsonlErrorRecord is a record of the jsonl output describing an error. The resource fields are only set for errors
+// of type jsonlErrorTypeAction.
+type jsonlErrorRecord struct {
+	Error     bool   `json:"error"`
+	Type      string `json:"type"`
+	Message   string `json:"message"`
+	App       string `json:"app,omitempty"`
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Action    string `json:"action,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+const (
+	// jsonlErrorTypeAction is the type of the error records of resources the action failed on
+	jsonlErrorTypeAction = "action"
+	// jsonlErrorTypeCommand is the type of the error records of errors which aborted the command
+	jsonlErrorTypeCommand = "command"
+)
+
+// printActionResultsJSONL prints each action result as a JSON object on a line of its own. Failed results are
+// printed as error records.
+func printActionResultsJSONL(w io.Writer, results []actionResult) error {
+	for _, result := range results {
+		var record interface{} = result
+		if result.Error != nil {
+			errRecord := jsonlErrorRecord{
+				Error:     true,
+				Type:      jsonlErrorTypeAction,
+				Message:   result.Error.Error(),
+				App:       result.App,
+				Group:     result.Group,
+				Kind:      result.Kind,
+				Namespace: result.Namespace,
+				Name:      result.Name,
+				Action:    result.Action,
+			}
+			if result.Reason != applicationpkg.ResourceActionFailureReason_Unknown {
+				errRecord.Reason = result.Reason.String()
+			}
+			record = errRecord
+		}
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w, string(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonlErrorHook is a log hook printing the errors logged by the command as error records of the jsonl output
+type jsonlErrorHook struct {
+	w io.Writer
+}
+
+func newJSONLErrorHook(w io.Writer) *jsonlErrorHook {
+	return &jsonlErrorHook{w: w}
+}
+
+func (h *jsonlErrorHook) Levels() []log.Level {
+	return []log.Level{log.PanicLevel, log.FatalLevel, log.ErrorLevel}
+}
+
+func (h *jsonlErrorHook) Fire(entry *log.Entry) error {
+	data, err := json.Marshal(jsonlErrorRecord{Error: true, Type: jsonlErrorTypeCommand, Message: entry.Message})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(h.w, string(data))
+	return err
+}
+
+// groupedActionResults are the action results grouped by outcome. Skipped results describe why the action was
+// not run as their error.
+type groupedActionResults struct {
+	Succeeded []actionResult `json:"succeeded"`
+	Failed    []actionResult `json:"failed"`
+	Skipped   []actionResult `json:"skipped"`
+}
+
+// groupActionResults groups the results of the actions which were run by outcome
+func groupActionResults(results []actionResult, skipped []actionResult) groupedActionResults {
+	grouped := groupedActionResults{
+		Succeeded: []actionResult{},
+		Failed:    []actionResult{},
+		Skipped:   skipped,
+	}
+	if grouped.Skipped == nil {
+		grouped.Skipped = []actionResult{}
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			grouped.Failed = append(grouped.Failed, result)
+		} else {
+			grouped.Succeeded = append(grouped.Succeeded, result)
+		}
+	}
+	return grouped
+}
+
+// skippedActionResults returns a result for each missing resource and for each object on which the action was
+// not run since a previous action failed
+func skippedActionResults(missing []*argoappv1.ResourceDiff, objs []*unstructured.Unstructured, results []actionResult, action string, stoppedOnSuccess bool) []actionResult {
+	notRunErr := fmt.Errorf("action was not run since a previous action failed")
+	if stoppedOnSuccess {
+		notRunErr = fmt.Errorf("action was not run since it already succeeded on another resource")
+	}
+	var skipped []actionResult
+	for _, res := range missing {
+		skipped = append(skipped, actionResult{
+			Group:     res.Group,
+			Kind:      res.Kind,
+			Namespace: res.Namespace,
+			Name:      res.Name,
+			Action:    action,
+			Error:     fmt.Errorf("resource does not exist in the cluster"),
+		})
+	}
+	ran := make(map[string]bool)
+	for _, result := range results {
+		ran[result.Group+"/"+result.Kind+"/"+result.Namespace+"/"+result.Name] = true
+	}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		if ran[gvk.Group+"/"+gvk.Kind+"/"+obj.GetNamespace()+"/"+obj.GetName()] {
+			continue
+		}
+		skipped = append(skipped, actionResult{
+			Group:     gvk.Group,
+			Kind:      gvk.Kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			Action:    action,
+			Error:     notRunErr,
+		})
+	}
+	return skipped
+}
+
+// declinedActionResults returns the skipped results of the objects on which running the action was declined
+func declinedActionResults(declined []*unstructured.Unstructured, action string) []actionResult {
+	var skipped []actionResult
+	for _, obj := range declined {
+		skipped = append(skipped, newActionResult(obj, action, fmt.Errorf("running the action was declined")))
+	}
+	return skipped
+}
+
+// unchangedActionResults returns the skipped results of the objects which did not change since --only-if-changed
+func unchangedActionResults(unchanged []*unstructured.Unstructured, action string, since string) []actionResult {
+	var skipped []actionResult
+	for _, obj := range unchanged {
+		skipped = append(skipped, newActionResult(obj, action, fmt.Errorf("resource did not change since %s", since)))
+	}
+	return skipped
+}
+
+// printActionResultsGrouped prints the action results grouped by outcome in the given output format
+func printActionResultsGrouped(w io.Writer, grouped groupedActionResults, output string) error {
+	var data []byte
+	var err error
+	switch output {
+	case "yaml":
+		data, err = yaml.Marshal(grouped)
+	default:
+		data, err = json.MarshalIndent(grouped, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// runOutputPlugin runs the output plugin with the JSON of the results on stdin, writing its stdout to out and its
+// stderr to errOut
+func runOutputPlugin(plugin string, results interface{}, out io.Writer, errOut io.Writer) error {
+	if list, ok := results.([]actionResult); ok && list == nil {
+		results = []actionResult{}
+	}
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(plugin)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = out
+	cmd.Stderr = errOut
+	return cmd.Run()
+}
+
+// checkOutputPluginError exits with the exit code of the output plugin if it failed, or with exitCodeActionFailed if
+// it could not be run
+func checkOutputPluginError(plugin string, err error) {
+	if err == nil {
+		return
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() > 0 {
+		fatalWithCode(exitErr.ExitCode(), "Output plugin '%s' failed with exit code %d", plugin, exitErr.ExitCode())
+	}
+	fatalWithCode(exitCodeActionFailed, "Failed to run output plugin '%s': %v", plugin, err)
+}
+
+// actionFailureExitCode returns the exit code for results of which some failed: exitCodePartialFailure if the action
+// also succeeded on some of the resources, exitCodeActionFailed otherwise
+func actionFailureExitCode(results []actionResult) int {
+	if countFailedResults(results) < len(results) {
+		return exitCodePartialFailure
+	}
+	return exitCodeActionFailed
+}
+
+// countFailedResults returns the number of results whose action failed
+func countFailedResults(results []actionResult) int {
+	failed := 0
+	for _, result := range results {
+		if result.Error != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// firstSucceededResult returns the first of the results whose action succeeded, or nil if none did
+func firstSucceededResult(results []actionResult) *actionResult {
+	for i := range results {
+		if results[i].Error == nil {
+			return &results[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestPrintActionResultsPartialFailure(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "a"), newDeployment("default", "b"), newDeployment("default", "c")}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{runErr: func(req *applicationpkg.ResourceActionRunRequest) error {
+		if req.ResourceName == "c" {
+			st, err := status.New(codes.NotFound, "action not available").WithDetails(&applicationpkg.ResourceActionFailure{Reason: applicationpkg.ResourceActionFailureReason_Unavailable})
+			assert.NoError(t, err)
+			return st.Err()
+		}
+		return nil
+	}}
+	results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), false, 1)
+	assert.Equal(t, 1, countFailedResults(results))
+
+	var out bytes.Buffer
+	assert.NoError(t, printActionResults(&out, results, "json"))
+	var printed []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &printed))
+	if assert.Len(t, printed, 3) {
+		assert.Equal(t, "a", printed[0]["name"])
+		assert.Equal(t, true, printed[0]["succeeded"])
+		assert.Nil(t, printed[0]["error"])
+		assert.Nil(t, printed[0]["reason"])
+		assert.Equal(t, "c", printed[2]["name"])
+		assert.Equal(t, false, printed[2]["succeeded"])
+		assert.Equal(t, "rpc error: code = NotFound desc = action not available", printed[2]["error"])
+		assert.Equal(t, "Unavailable", printed[2]["reason"])
+	}
+}
+
+func TestPrintActionResultsJSONL(t *testing.T) {
+	results := []actionResult{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "a", Action: "restart", ResourceRevision: 3},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "b", Action: "restart", Error: fmt.Errorf("permission denied"), Reason: applicationpkg.ResourceActionFailureReason_Forbidden},
+	}
+	var out bytes.Buffer
+	assert.NoError(t, printActionResults(&out, results, "jsonl"))
+	// errors logged by the command are printed to the same stream
+	hook := newJSONLErrorHook(&out)
+	assert.NoError(t, hook.Fire(&log.Entry{Message: "1 of 2 actions failed"}))
+	assert.Equal(t, `{"group":"apps","kind":"Deployment","namespace":"default","name":"a","action":"restart","succeeded":true,"revision":3}
+{"error":true,"type":"action","message":"permission denied","group":"apps","kind":"Deployment","namespace":"default","name":"b","action":"restart","reason":"Forbidden"}
+{"error":true,"type":"command","message":"1 of 2 actions failed"}
+`, out.String())
+}
+
+func TestPrintActionResultDiffs(t *testing.T) {
+	paused := `{"kind":"Rollout","metadata":{"name":"a"},"spec":{"paused":true}}`
+	resumed := `{"kind":"Rollout","metadata":{"name":"a"},"spec":{"paused":false}}`
+	results := []actionResult{
+		{Group: "argoproj.io", Kind: "Rollout", Namespace: "default", Name: "a", LiveState: paused, TargetState: resumed},
+		// unchanged resources and failures are not printed
+		{Group: "argoproj.io", Kind: "Rollout", Namespace: "default", Name: "b", LiveState: resumed, TargetState: resumed},
+		{Group: "argoproj.io", Kind: "Rollout", Namespace: "default", Name: "c", Error: fmt.Errorf("forbidden")},
+	}
+	var printed []string
+	var out bytes.Buffer
+	changed, err := printActionResultDiffs(&out, results, func(name string, live *unstructured.Unstructured, target *unstructured.Unstructured) error {
+		livePaused, _, _ := unstructured.NestedBool(live.Object, "spec", "paused")
+		targetPaused, _, _ := unstructured.NestedBool(target.Object, "spec", "paused")
+		printed = append(printed, fmt.Sprintf("%s: %v -> %v", name, livePaused, targetPaused))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, []string{"a: true -> false"}, printed)
+	assert.Equal(t, "===== argoproj.io/Rollout default/a ======\n", out.String())
+
+	_, err = printActionResultDiffs(&out, []actionResult{{Kind: "Rollout", Namespace: "default", Name: "a", LiveState: paused}}, nil)
+	assert.EqualError(t, err, "Failed to parse the dry run state of Rollout default/a: unexpected end of JSON input")
+}
+
+func TestPrintActionResultDrift(t *testing.T) {
+	desired := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"%s","namespace":"default"},"spec":{"replicas":%d}}`
+	deployment := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"%s","namespace":"default"},"spec":{"replicas":%d,"paused":%v}}`
+	resources := []*argoappv1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "scaled", TargetState: fmt.Sprintf(desired, "scaled", 1)},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "paused", TargetState: fmt.Sprintf(desired, "paused", 1)},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "out-of-sync", TargetState: fmt.Sprintf(desired, "out-of-sync", 1)},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "not-in-git", TargetState: "null"},
+	}
+	results := []actionResult{
+		// the action changes the replicas, which are set in git
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "scaled", Action: "scale",
+			LiveState: fmt.Sprintf(deployment, "scaled", 1, false), TargetState: fmt.Sprintf(deployment, "scaled", 3, false)},
+		// the action changes a field which is not set in git
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "paused", Action: "pause",
+			LiveState: fmt.Sprintf(deployment, "paused", 1, false), TargetState: fmt.Sprintf(deployment, "paused", 1, true)},
+		// the resource already differs from git, which the action leaves as it is
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "out-of-sync", Action: "pause",
+			LiveState: fmt.Sprintf(deployment, "out-of-sync", 2, false), TargetState: fmt.Sprintf(deployment, "out-of-sync", 2, true)},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "not-in-git", Action: "scale",
+			LiveState: fmt.Sprintf(deployment, "not-in-git", 1, false), TargetState: fmt.Sprintf(deployment, "not-in-git", 3, false)},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "failed", Error: fmt.Errorf("forbidden")},
+	}
+	var printed []string
+	var out bytes.Buffer
+	drifted, err := printActionResultDrift(&out, results, resources, nil, func(name string, live *unstructured.Unstructured, target *unstructured.Unstructured) error {
+		liveReplicas, _, _ := unstructured.NestedFieldNoCopy(live.Object, "spec", "replicas")
+		targetReplicas, _, _ := unstructured.NestedFieldNoCopy(target.Object, "spec", "replicas")
+		printed = append(printed, fmt.Sprintf("%s: %v -> %v", name, liveReplicas, targetReplicas))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, drifted)
+	assert.Equal(t, []string{"scaled: 3 -> 1"}, printed)
+	assert.Equal(t, "===== drift apps/Deployment default/scaled ======\n", out.String())
+}
+
+func TestRunOutputPlugin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "output-plugin")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	plugin := filepath.Join(dir, "plugin")
+	// the plugin prints the names of the resources the action failed on
+	assert.NoError(t, ioutil.WriteFile(plugin, []byte("#!/bin/sh\necho failed:; grep -o '\"error\": \"[^\"]*\"'; echo done >&2\n"), 0755))
+
+	results := []actionResult{
+		{Kind: "Deployment", Namespace: "default", Name: "a", Action: "restart"},
+		{Kind: "Deployment", Namespace: "default", Name: "b", Action: "restart", Error: fmt.Errorf("boom")},
+	}
+	var out, errOut bytes.Buffer
+	assert.NoError(t, runOutputPlugin(plugin, results, &out, &errOut))
+	assert.Equal(t, "failed:\n\"error\": \"boom\"\n", out.String())
+	assert.Equal(t, "done\n", errOut.String())
+
+	failing := filepath.Join(dir, "failing")
+	assert.NoError(t, ioutil.WriteFile(failing, []byte("#!/bin/sh\ncat >/dev/null; exit 4\n"), 0755))
+	err = runOutputPlugin(failing, []actionResult(nil), &out, &errOut)
+	if exitErr, ok := err.(*exec.ExitError); assert.True(t, ok) {
+		assert.Equal(t, 4, exitErr.ExitCode())
+	}
+}
+
+func TestPrintActionResultsRevisions(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "a"), newDeployment("default", "b")}
+	appName := "guestbook"
+	for _, chunkSize := range []int{0, 2} {
+		client := &fakeAppServiceClient{revisions: map[string]int64{"a": 4}}
+		results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "promote"}, objs, 1, chunkSize, newActionRateLimiter(0), false, 1)
+
+		var out bytes.Buffer
+		printActionResultsRevisions(&out, results)
+		// resources whose controller records no revision are not printed
+		assert.Equal(t, "promoted Deployment default/a to revision 4\n", out.String())
+
+		out.Reset()
+		assert.NoError(t, printActionResults(&out, results, "json"))
+		var printed []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(out.Bytes(), &printed))
+		if assert.Len(t, printed, 2) {
+			assert.Equal(t, float64(4), printed[0]["revision"])
+			assert.Nil(t, printed[1]["revision"])
+		}
+	}
+
+	var out bytes.Buffer
+	printActionResultsRevisions(&out, []actionResult{{Kind: "Rollout", Namespace: "default", Name: "guestbook", Action: "resume", ResourceRevision: 2}})
+	assert.Equal(t, "ran resume on Rollout default/guestbook, which is at revision 2\n", out.String())
+}
+
+func TestPrintResultingObjects(t *testing.T) {
+	results := []actionResult{
+		{Kind: "Deployment", Namespace: "default", Name: "a", TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"a","managedFields":[{"manager":"argocd"}]}}`},
+		{Kind: "Deployment", Namespace: "default", Name: "b", Error: fmt.Errorf("failed"), TargetState: `{"kind":"Deployment"}`},
+		{Kind: "Deployment", Namespace: "default", Name: "c", TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"c"}}`},
+	}
+	var out bytes.Buffer
+	assert.NoError(t, printResultingObjects(&out, results, false))
+	// failed results are left out
+	assert.Equal(t, `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: a
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: c
+`, out.String())
+
+	out.Reset()
+	assert.NoError(t, printResultingObjects(&out, results[:1], true))
+	assert.Contains(t, out.String(), "managedFields:\n  - manager: argocd\n")
+
+	assert.Error(t, printResultingObjects(&out, []actionResult{{TargetState: "{"}}, false))
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "snapshot.yaml")
+
+	managed := newDeployment("default", "a")
+	assert.NoError(t, unstructured.SetNestedSlice(managed.Object, []interface{}{map[string]interface{}{"manager": "argocd"}}, "metadata", "managedFields"))
+	assert.NoError(t, writeSnapshot(path, []*unstructured.Unstructured{managed, newDeployment("staging", "b")}, false))
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	docs := strings.Split(strings.TrimPrefix(string(data), "---\n"), "---\n")
+	if assert.Len(t, docs, 2) {
+		var obj unstructured.Unstructured
+		assert.NoError(t, yaml.Unmarshal([]byte(docs[1]), &obj.Object))
+		assert.Equal(t, "staging", obj.GetNamespace())
+		assert.Equal(t, "b", obj.GetName())
+	}
+	assert.NotContains(t, string(data), "managedFields")
+	// the objects the action runs on are left as they are
+	_, found, _ := unstructured.NestedSlice(managed.Object, "metadata", "managedFields")
+	assert.True(t, found)
+
+	assert.NoError(t, writeSnapshot(path, []*unstructured.Unstructured{managed}, true))
+	data, err = ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "managedFields:\n  - manager: argocd\n")
+
+	assert.Error(t, writeSnapshot(filepath.Join(dir, "missing", "snapshot.yaml"), nil, false))
+}
+
+func TestPrintActionResultsGrouped(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "a"), newDeployment("default", "b"), newDeployment("default", "c")}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{runErr: func(req *applicationpkg.ResourceActionRunRequest) error {
+		if req.ResourceName == "b" {
+			return fmt.Errorf("action not available")
+		}
+		return nil
+	}}
+	results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), false, 1)
+	missing := []*argoappv1.ResourceDiff{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "missing"}}
+
+	var out bytes.Buffer
+	grouped := groupActionResults(results, skippedActionResults(missing, objs, results, "restart", false))
+	assert.NoError(t, printActionResultsGrouped(&out, grouped, "json"))
+	var printed map[string][]map[string]interface{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &printed))
+	if assert.Len(t, printed["succeeded"], 1) {
+		assert.Equal(t, "a", printed["succeeded"][0]["name"])
+	}
+	if assert.Len(t, printed["failed"], 1) {
+		assert.Equal(t, "b", printed["failed"][0]["name"])
+		assert.Equal(t, "action not available", printed["failed"][0]["error"])
+	}
+	if assert.Len(t, printed["skipped"], 2) {
+		assert.Equal(t, "missing", printed["skipped"][0]["name"])
+		assert.Equal(t, "resource does not exist in the cluster", printed["skipped"][0]["error"])
+		assert.Equal(t, "c", printed["skipped"][1]["name"])
+		assert.Equal(t, "action was not run since a previous action failed", printed["skipped"][1]["error"])
+	}
+}
+
+func TestActionFailureExitCode(t *testing.T) {
+	succeeded := actionResult{Kind: "Deployment", Name: "web"}
+	failed := actionResult{Kind: "Deployment", Name: "api", Error: fmt.Errorf("forbidden")}
+	assert.Equal(t, exitCodeActionFailed, actionFailureExitCode([]actionResult{failed}))
+	assert.Equal(t, exitCodeActionFailed, actionFailureExitCode([]actionResult{failed, failed}))
+	assert.Equal(t, exitCodePartialFailure, actionFailureExitCode([]actionResult{succeeded, failed}))
+}
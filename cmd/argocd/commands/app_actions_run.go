@@ -0,0 +1,1637 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/common"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	sessionpkg "github.com/argoproj/argo-cd/pkg/apiclient/session"
+	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
+	"github.com/argoproj/argo-cd/pkg/apis/application"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/localconfig"
+	"github.com/argoproj/argo-cd/util/resource/syncwaves"
+)
+
+// serverRunOptions are the run options declared in the settings of the server which the run flags require. Servers
+// which do not declare an option would silently ignore the flag
+var serverRunOptions = []struct {
+	flag   string
+	option string
+}{
+	{"revision", "revision"},
+	{"on-application", "applicationActions"},
+	{"annotate-run", "runAnnotationKey"},
+	{"chunk-size", "batch"},
+	{"record", "recordedCommand"},
+	{"field-manager", "fieldManager"},
+	{"diff-only", "dryRun"},
+	{"reason", "reason"},
+	{"resource-version", "resourceVersion"},
+	{"output-objects", "returnTargetState"},
+}
+
+// redactedFlags are the flags whose values are not included in recorded command lines
+var redactedFlags = []string{"auth-token"}
+
+// recordedCommandLine returns the command line to record for an action run, with the values of redacted flags
+// replaced
+func recordedCommandLine(args []string) string {
+	var recorded []string
+	redactNext := false
+	for i, arg := range args {
+		switch {
+		case i == 0:
+			arg = filepath.Base(arg)
+		case redactNext:
+			arg = "REDACTED"
+			redactNext = false
+		case strings.HasPrefix(arg, "--"):
+			name := strings.TrimPrefix(arg, "--")
+			if parts := strings.SplitN(name, "=", 2); len(parts) == 2 {
+				if containsString(redactedFlags, parts[0]) {
+					arg = "--" + parts[0] + "=REDACTED"
+				}
+			} else if containsString(redactedFlags, name) {
+				redactNext = true
+			}
+		}
+		if strings.ContainsAny(arg, " \t\n'\"") {
+			arg = strconv.Quote(arg)
+		}
+		recorded = append(recorded, arg)
+	}
+	return strings.Join(recorded, " ")
+}
+
+// defaultRunAnnotationKey is the default annotation recording action runs on resources
+const defaultRunAnnotationKey = "argocd.argoproj.io/last-action-run"
+
+// actionExitCodesHelp documents the exit codes of the resource action commands
+const actionExitCodesHelp = `Exit codes:
+  0  Success
+  1  The action failed on all resources it was run on, or another error occurred
+  2  No resources matched the selectors
+  3  Invalid arguments or flags
+  4  The action succeeded on some resources, but failed on others`
+
+// outputPluginHelp documents the input passed to the program given with --output-plugin
+const outputPluginHelp = `Output plugins:
+  The program given with --output-plugin receives the results as a JSON array on stdin, with an object for each
+  resource the action was run on, e.g.:
+
+    [{"group": "apps", "kind": "Deployment", "namespace": "default", "name": "guestbook", "action": "restart",
+      "succeeded": false, "error": "permission denied", "reason": "Forbidden"}]
+
+  "app" is only set when running the action across applications, "error" and "reason" only if the action failed,
+  "revision" and "generation" only if they were reported for the resource. With --group-results, the input is an
+  object with the "succeeded", "failed" and "skipped" arrays of results instead. The output of the program is
+  printed as is. If it fails, the command exits with its exit code.`
+
+// jsonlOutputHelp documents the records printed with --out jsonl
+const jsonlOutputHelp = `JSON lines output:
+  With --out jsonl, a JSON object is printed on a line of its own for each resource the action succeeded on, with the
+  fields of the json output. Errors are printed on the same stream as records tagged with "error": true, e.g.:
+
+    {"group": "apps", "kind": "Deployment", "namespace": "default", "name": "guestbook", "action": "restart",
+     "succeeded": true, "revision": 3}
+    {"error": true, "type": "action", "message": "permission denied", "group": "apps", "kind": "Deployment",
+     "namespace": "default", "name": "guestbook-ui", "action": "restart", "reason": "Forbidden"}
+    {"error": true, "type": "command", "message": "1 of 2 actions failed"}
+
+  "type" is "action" for a resource the action failed on, which is described by the same fields as its result, or
+  "command" for an error which aborted the command. A command error is the last record printed. Invalid arguments
+  are only reported on stderr, as nothing is printed to stdout before they are validated.`
+
+// runFlagRules are the flag combinations validated by the run command
+var runFlagRules = flagRules{
+	conflicts: [][2]string{
+		{"on-application", "resource-name"},
+		{"on-application", "namespace"},
+		{"on-application", "namespace-selector"},
+		{"on-application", "older-than"},
+		{"on-application", "max-age"},
+		{"on-application", "sync-status"},
+		{"on-application", "health"},
+		{"on-application", "image"},
+		{"on-application", "selector"},
+		{"on-application", "kind"},
+		{"on-application", "all"},
+		{"on-application", "revision"},
+		{"on-application", "profile"},
+		{"on-application", "wait"},
+		{"on-application", "project"},
+		{"on-application", "app-selector"},
+		{"on-application", "field-manager"},
+		{"project", "interactive"},
+		{"app-selector", "interactive"},
+		{"project", "save-last"},
+		{"app-selector", "save-last"},
+		{"project", "revision"},
+		{"app-selector", "revision"},
+		{"project", "group-results"},
+		{"app-selector", "group-results"},
+		{"project", "wait"},
+		{"app-selector", "wait"},
+		{"project", "post-action-health-check"},
+		{"app-selector", "post-action-health-check"},
+		{"project", "confirm-each"},
+		{"app-selector", "confirm-each"},
+		{"recursive", "on-application"},
+		{"recursive", "project"},
+		{"recursive", "app-selector"},
+		{"app-selector", "project"},
+		{"recursive", "interactive"},
+		{"recursive", "save-last"},
+		{"recursive", "revision"},
+		{"recursive", "group-results"},
+		{"recursive", "wait"},
+		{"recursive", "post-action-health-check"},
+		{"recursive", "confirm-each"},
+		{"stop-on-first-success", "project"},
+		{"stop-on-first-success", "app-selector"},
+		{"stop-on-first-success", "recursive"},
+		{"wait", "post-action-health-check"},
+		{"before-hook", "chunk-size"},
+		{"after-hook", "chunk-size"},
+		{"diff-only", "on-application"},
+		{"diff-only", "project"},
+		{"diff-only", "app-selector"},
+		{"diff-only", "recursive"},
+		// servers older than v1.3.0 ignore the dry run and resource version of batch calls, which --chunk-size makes
+		{"diff-only", "chunk-size"},
+		{"diff-only", "out"},
+		{"diff-only", "tee"},
+		{"diff-only", "wait"},
+		{"diff-only", "post-action-health-check"},
+		{"diff-only", "annotate-run"},
+		{"diff-only", "record"},
+		{"diff-only", "save-last"},
+		{"diff-only", "stop-on-first-success"},
+		{"diff-only", "before-hook"},
+		{"diff-only", "after-hook"},
+		{"diff-only", "output-plugin"},
+		{"diff-only", "observe-events"},
+		{"diff-only", "audit-sink"},
+		{"observe-events", "on-application"},
+		{"observe-events", "project"},
+		{"observe-events", "app-selector"},
+		{"observe-events", "recursive"},
+		{"output-plugin", "out"},
+		{"continue-on-error", "stop-on-first-success"},
+		{"continue-on-error", "project"},
+		{"continue-on-error", "app-selector"},
+		{"continue-on-error", "recursive"},
+		{"only-if-changed", "on-application"},
+		{"only-if-changed", "project"},
+		{"only-if-changed", "app-selector"},
+		{"only-if-changed", "recursive"},
+		{"post-sync", "stop-on-first-success"},
+		{"post-sync", "on-application"},
+		{"post-sync", "project"},
+		{"post-sync", "app-selector"},
+		{"post-sync", "recursive"},
+		{"from-kubectl", "resource-name"},
+		{"from-kubectl", "selector"},
+		{"from-kubectl", "on-application"},
+		{"from-kubectl", "project"},
+		{"from-kubectl", "app-selector"},
+		{"from-kubectl", "recursive"},
+		{"from-kubectl", "selector-from-resource"},
+		{"selector-from-resource", "on-application"},
+		{"selector-from-resource", "project"},
+		{"selector-from-resource", "app-selector"},
+		{"selector-from-resource", "recursive"},
+		{"resource-version", "all"},
+		{"resource-version", "project"},
+		{"resource-version", "app-selector"},
+		{"resource-version", "recursive"},
+		// see diff-only: older servers would run the batch without the resource version check
+		{"resource-version", "chunk-size"},
+		{"output-objects", "out"},
+		{"output-objects", "output-plugin"},
+		{"output-objects", "group-results"},
+		{"output-objects", "diff-only"},
+		{"output-objects", "on-application"},
+		{"output-objects", "project"},
+		{"output-objects", "app-selector"},
+		{"output-objects", "recursive"},
+		{"snapshot-file", "on-application"},
+		{"snapshot-file", "project"},
+		{"snapshot-file", "app-selector"},
+		{"snapshot-file", "recursive"},
+	},
+	requires: [][]string{
+		{"group-results", "out", "output-plugin"},
+		{"project", "all"},
+		{"app-selector", "all"},
+		{"recursive", "all"},
+		{"yes", "project", "app-selector", "recursive"},
+		{"annotate-run-key", "annotate-run"},
+		{"watch-timeout", "wait"},
+		{"confirm-each", "all"},
+		{"stop-on-first-success", "all"},
+		{"ignore-hook-errors", "before-hook"},
+		{"fail-on-diff", "diff-only"},
+		{"check-drift", "diff-only"},
+		{"max-failures", "continue-on-error"},
+		{"observe-duration", "observe-events"},
+		{"wave-delay", "post-sync"},
+		{"resource-version", "resource-name", "on-application"},
+		{"show-managed-fields", "output-objects", "snapshot-file"},
+	},
+}
+
+// getServerRunOptions returns the run options supported by the connected server, or false if they cannot be
+// determined
+func getServerRunOptions(ctx context.Context, acdClient argocdclient.Client) ([]string, bool) {
+	conn, settingsIf := acdClient.NewSettingsClientOrDie()
+	defer util.Close(conn)
+	settings, err := settingsIf.Get(ctx, &settingspkg.SettingsQuery{})
+	if err != nil {
+		log.Warnf("Failed to determine the run options supported by the server: %v", err)
+		return nil, false
+	}
+	return settings.ResourceActionRunOptions, true
+}
+
+// unsupportedServerFeatures returns an error message for each flag set on the command which requires a run option
+// the server does not support
+func unsupportedServerFeatures(command *cobra.Command, supportedOptions []string) []string {
+	var messages []string
+	for _, feature := range serverRunOptions {
+		if command.Flags().Changed(feature.flag) && !containsString(supportedOptions, feature.option) {
+			messages = append(messages, fmt.Sprintf("--%s is not supported by the server, which does not support the '%s' run option", feature.flag, feature.option))
+		}
+	}
+	return messages
+}
+
+// NewApplicationResourceActionsRunCommand returns a new instance of an `argocd app actions run` command
+func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var namespace string
+	var resourceName string
+	var kindArg string
+	var all bool
+	var revision string
+	var parallelism int
+	var profile string
+	var selectorFilePath string
+	var fromKubectl string
+	var tee string
+	var outputPlugin string
+	var auditSink string
+	var auditSinkTimeout uint
+	var beforeHook string
+	var afterHook string
+	var ignoreHookErrors bool
+	var verbose bool
+	var onMissing string
+	var selector string
+	var selectorFromResource string
+	var namespaceSelector string
+	var olderThan string
+	var maxAge string
+	var syncStatus string
+	var health string
+	var image string
+	var postActionHealthCheck bool
+	var wait bool
+	var noDeprecationWarnings bool
+	var projects []string
+	var appSelector string
+	var recursive bool
+	var yes bool
+	var confirmEach bool
+	var stopOnFirstSuccess bool
+	var timeout uint
+	var watchTimeout uint
+	var observeEvents bool
+	var observeDuration uint
+	var managedResourcesTimeout uint
+	var output string
+	var explain bool
+	var onApplication bool
+	var saveLast bool
+	var annotateRun bool
+	var annotateRunKey string
+	var record bool
+	var reason string
+	var resourceVersion string
+	var outputObjects bool
+	var snapshotFile string
+	var showManagedFields bool
+	var fieldManager string
+	var interactive bool
+	var groupResults bool
+	var qps float64
+	var chunkSize int
+	var diffOnly bool
+	var failOnDiff bool
+	var checkDrift bool
+	var continueOnError bool
+	var maxFailures int
+	var postSync bool
+	var waveDelay uint
+	var onlyIfChanged string
+	var command = &cobra.Command{
+		Use:   "run [APPNAME] [ACTION]",
+		Short: "Runs an available action on resource(s)",
+		Long: `Runs an available action on resource(s).
+
+By default the action is run on resources managed by the application. Use --on-application to run an action
+registered for the Application kind on the Application resource itself, e.g.:
+
+	argocd app actions run APPNAME argoproj.io/Application/ACTION --on-application
+
+The action is given as GROUP/KIND/ACTION, e.g. argoproj.io/Rollout/pause. The group may be omitted, e.g. Rollout/pause,
+in which case it is resolved from the managed resources of that kind.
+
+Use --project to run the action on the matching resources of all applications in the given project(s), e.g.:
+
+	argocd app actions run argoproj.io/Rollout/pause --project PROJECT --all
+
+Use --app-selector to run the action on the matching resources of all applications with the given labels, e.g.:
+
+	argocd app actions run argoproj.io/Rollout/pause --app-selector team=payments --all
+
+Use --recursive to also run the action on the matching resources of the child applications of an app of apps, e.g.:
+
+	argocd app actions run APPNAME argoproj.io/Rollout/pause --recursive --all
+
+Use --from-kubectl to run the action on the resources listed by a kubectl query, which are matched against the
+managed resources of the application, e.g.:
+
+	kubectl get rollouts -l tier=web -o json | argocd app actions run APPNAME argoproj.io/Rollout/restart --all --from-kubectl -
+
+Use --post-sync to run the action in the order in which the resources are synced, wave by wave by their sync wave
+annotation, e.g. with a pause of a minute between waves:
+
+	argocd app actions run APPNAME apps/Deployment/restart --all --post-sync --wave-delay 60
+
+Use --diff-only to review the changes an action would make without applying them. The action is submitted as a server
+side dry run and a diff of each resource is printed, e.g.:
+
+	argocd app actions run APPNAME argoproj.io/Rollout/resume --all --diff-only --fail-on-diff
+
+Add --check-drift to also compare the state each resource would be in after the action with its desired state in git.
+A warning and a diff of the changes the next sync would revert are printed for each resource the action would make
+diverge from git, e.g. a scale action on a deployment whose replicas are set in git:
+
+	argocd app actions run APPNAME apps/Deployment/scale --all --diff-only --check-drift
+
+` + outputPluginHelp + `
+
+` + jsonlOutputHelp + `
+
+` + actionExitCodesHelp,
+	}
+
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
+	command.Flags().String("source-path", "", sourcePathFlagHelp)
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace. Cluster-scoped resources match any namespace")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Label selector, supporting equality-based and set-based requirements (e.g. -l 'env in (staging,prod),tier!=cache')")
+	command.Flags().StringVar(&selectorFromResource, "selector-from-resource", "", "Select the resources with the labels of the managed resource KIND/NAME or GROUP/KIND/NAME, e.g. Deployment/guestbook-ui. Combined with --selector, if set")
+	command.Flags().StringVar(&namespaceSelector, "namespace-selector", "", "Namespace label selector. Only selects resources in namespaces which are managed by the application and whose labels match")
+	command.Flags().StringVar(&olderThan, "older-than", "", "Only select resources created at least this long ago, e.g. 7d or 36h")
+	command.Flags().StringVar(&maxAge, "max-age", "", "Only select resources created at most this long ago, e.g. 7d or 36h")
+	command.Flags().StringVar(&syncStatus, "sync-status", "", "Only select resources with the given sync status. One of: Synced, OutOfSync, Unknown")
+	command.Flags().StringVar(&health, "health", "", "Only select resources with the given health. One of: Healthy, Progressing, Degraded, Suspended, Missing, Unknown")
+	command.Flags().StringVar(&image, "image", "", fmt.Sprintf("Only select workloads with a container or init container whose image matches the regular expression, e.g. '^guestbook:v1\\.2'. The pod templates of the kinds %s are inspected; other resources are not selected", strings.Join(imageKinds, ", ")))
+	command.Flags().StringVar(&kindArg, "kind", "", "Kind (singular or plural, e.g. Deployment or deployments). Several kinds may be given comma separated, e.g. Deployment,StatefulSet")
+	command.Flags().BoolVar(&all, "all", false, "Indicates whether to run the action on multiple matching resources")
+	command.Flags().BoolVar(&confirmEach, "confirm-each", false, "Ask for confirmation before running the action on each of the matching resources. Requires --all and a terminal")
+	command.Flags().BoolVar(&stopOnFirstSuccess, "stop-on-first-success", false, "Stop after the action succeeded on one of the matching resources, e.g. to trigger a shared job. Unlike by default, a failure does not stop the run, and the command only fails if the action succeeded on none of the resources. Requires --all")
+	command.Flags().StringVar(&revision, "revision", "", "Run the action against the resource definition at the given revision instead of the live object. Only the changes made by the action are applied to the live object")
+	command.Flags().IntVar(&parallelism, "parallel", 1, "Number of resources to run the action on in parallel")
+	command.Flags().IntVar(&chunkSize, "chunk-size", 0, "Run the action on up to this many resources with a single server call. Disabled by default, in which case each resource is a separate call")
+	command.Flags().Float64Var(&qps, "qps", defaultActionQPS, "Maximum number of actions to run per second, shared across all parallel workers. Set to 0 to disable rate limiting")
+	command.Flags().StringVar(&profile, "profile", "", "Name of a selector profile from the CLI config. Explicitly specified selector flags take precedence over the profile")
+	command.Flags().StringVar(&selectorFilePath, "selector-file", "", "Path to a yaml file with a set of selectors: kinds, namespaces, labels and exclude. The --kind, --namespace and --selector flags take precedence over the file, whether specified explicitly or by --profile")
+	command.Flags().StringVar(&fromKubectl, "from-kubectl", "", "Path to the output of 'kubectl get -o json', or - to read it from stdin. Only selects the listed resources. Listed resources which are not managed by the application are skipped with a warning")
+	command.Flags().BoolVarP(&verbose, "verbose", "v", false, "Log each resource action call made to the server, including the Lua stack trace of actions which fail")
+	command.Flags().BoolVar(&explain, "explain", false, "Print to stderr why each managed resource was or wasn't selected")
+	command.Flags().StringVarP(&output, "out", "o", "", "Output format of the action results. One of: yaml, json, jsonl. Results are printed even if some actions failed. See below for the records printed with jsonl")
+	command.Flags().StringVar(&tee, "tee", "", "Also write the output to the given file, which is overwritten, while printing it to stdout")
+	command.Flags().StringVar(&auditSink, "audit-sink", "", "URL of an HTTP endpoint to post a JSON record of each action run to, with the resource, action, user, result and time. Failures to post the records are retried, and only warned about")
+	command.Flags().UintVar(&auditSinkTimeout, "audit-sink-timeout", defaultAuditSinkTimeoutSeconds, "Time out each request posting a record to the --audit-sink after this many seconds. The records which were not posted within three times this timeout, including retries, are given up on. Set to 0 to disable the timeout")
+	command.Flags().StringVar(&outputPlugin, "output-plugin", "", "Path of a program which receives the action results as JSON on stdin and prints them in a format of its own. See below for the input passed to it")
+	command.Flags().StringVar(&beforeHook, "before-hook", "", "Shell command to run before the action is run on each resource. The resource is passed in the ARGOCD_APP_NAME, ARGOCD_ACTION, ARGOCD_RESOURCE_GROUP, ARGOCD_RESOURCE_KIND, ARGOCD_RESOURCE_NAMESPACE and ARGOCD_RESOURCE_NAME environment variables. The action is not run on the resource if the hook fails")
+	command.Flags().StringVar(&afterHook, "after-hook", "", "Shell command to run after the action was run on each resource, with the same environment variables as --before-hook along with ARGOCD_ACTION_ERROR if the action failed. A failing after hook is logged but does not fail the action")
+	command.Flags().BoolVar(&ignoreHookErrors, "ignore-hook-errors", false, "Run the action even if --before-hook fails")
+	command.Flags().BoolVar(&postActionHealthCheck, "post-action-health-check", false, "Refresh the application once after running the action and print the current health of the affected resources")
+	command.Flags().BoolVar(&wait, "wait", false, "Wait for the affected resources to become healthy or suspended after running the action, printing each health transition to stderr as it happens")
+	command.Flags().UintVar(&timeout, "timeout", defaultActionCallTimeoutSeconds, "Time out each action call made to the server after this many seconds. Set to 0 to disable the timeout")
+	command.Flags().UintVar(&managedResourcesTimeout, "managed-resources-timeout", defaultManagedResourcesTimeoutSeconds, "Time out listing the managed resources of the application after this many seconds. Independent of --timeout, which only applies to the action calls. Set to 0 to disable the timeout")
+	command.Flags().BoolVar(&observeEvents, "observe-events", false, "Print the Kubernetes events of the resources the action succeeded on, with their timestamps, for a while after running the action")
+	command.Flags().UintVar(&observeDuration, "observe-duration", defaultObserveDurationSeconds, "Print events for this many seconds when used with --observe-events")
+	command.Flags().UintVar(&watchTimeout, "watch-timeout", defaultWatchTimeoutSeconds, "Stop waiting for the affected resources to become healthy after this many seconds when used with --wait. Independent of --timeout, which only applies to the action calls")
+	command.Flags().StringVar(&onlyIfChanged, "only-if-changed", "", "Only run the action on resources which changed since the given RFC3339 timestamp, the given duration ago, e.g. 7d or 36h, or since the action was last run on them if set to "+changedSinceLastRun+", as recorded by --annotate-run. Changes are determined from the managed fields of the resources, so resources which do not record them are always considered changed, while changes made by actions are ignored")
+	command.Flags().StringVar(&onMissing, "on-missing", onMissingSkip, "What to do when a matching resource is declared in the application but does not exist in the cluster. One of: skip, fail")
+	command.Flags().BoolVar(&onApplication, "on-application", false, "Run the action on the Application resource itself rather than on its managed resources")
+	command.Flags().BoolVar(&groupResults, "group-results", false, "Group the action results printed with --out by outcome, i.e. {succeeded: [...], failed: [...], skipped: [...]}, instead of printing a flat list. Skipped resources are those which do not exist in the cluster, which were declined with --confirm-each, which did not change since --only-if-changed or on which the action was not run since a previous action failed")
+	command.Flags().BoolVar(&interactive, "interactive", false, "If no action is specified, choose one of the actions available on the single selected resource from a menu. Requires a terminal")
+	command.Flags().BoolVar(&annotateRun, "annotate-run", false, "Record the action, the user running it and the time it was run in an annotation of each resource the action is run on")
+	command.Flags().StringVar(&annotateRunKey, "annotate-run-key", defaultRunAnnotationKey, "Key of the annotation written by --annotate-run")
+	command.Flags().BoolVar(&record, "record", false, "Record the action run, including the command line with secrets redacted, in the event history of the application")
+	command.Flags().BoolVar(&outputObjects, "output-objects", false, "Print the resources the action succeeded on as they are after the action, as multi-document yaml, instead of the revisions they are at. The managed fields of the resources are left out unless --show-managed-fields is set")
+	command.Flags().StringVar(&snapshotFile, "snapshot-file", "", "Before running the action, write the current manifests of the resources it runs on to the file as multi-document yaml, to restore them manually if needed. The managed fields of the resources are left out unless --show-managed-fields is set")
+	command.Flags().BoolVar(&showManagedFields, "show-managed-fields", false, "Include the managed fields of the resources printed with --output-objects or written to --snapshot-file")
+	command.Flags().StringVar(&resourceVersion, "resource-version", "", "Only run the action if the resource is at the given resource version, e.g. as listed by 'kubectl get -o yaml'. Fails with a conflict if the resource was modified since. Requires --resource-name or --on-application")
+	command.Flags().StringVar(&reason, "reason", "", "Why the action is run, e.g. a ticket number. It is recorded in the event history of the application, which is listed by 'argocd app actions history', and in the annotation written by --annotate-run. Required if the server enforces resource.actions.requireReason")
+	command.Flags().StringVar(&fieldManager, "field-manager", common.ArgoCDActionsFieldManager, "Name of the field manager the changes made by the action are recorded with in the managed fields of each resource. Using a name of its own keeps the fields changed by actions apart from those owned by other controllers")
+	command.Flags().StringArrayVar(&projects, "project", []string{}, "Run the action on the matching resources of all applications in the project instead of a single application. Requires --all. Can be repeated")
+	command.Flags().StringVar(&appSelector, "app-selector", "", "Run the action on the matching resources of all applications matching the label selector, e.g. team=payments, instead of a single application. Requires --all")
+	command.Flags().BoolVar(&recursive, "recursive", false, "Also run the action on the matching resources of the child applications managed by the application, and of their children. Requires --all")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Do not ask for confirmation before running the action across the applications of a project, of an application selector or of an application tree")
+	command.Flags().BoolVar(&noDeprecationWarnings, "no-deprecation-warnings", false, "Do not print warnings about deprecated usage to stderr")
+	command.Flags().BoolVar(&continueOnError, "continue-on-error", false, "Keep running the action on the remaining resources after it failed on one of them. The command still fails if any of the actions failed")
+	command.Flags().IntVar(&maxFailures, "max-failures", 0, "Abort the run once the action failed on this many resources when used with --continue-on-error. Defaults to no limit")
+	command.Flags().BoolVar(&postSync, "post-sync", false, "Run the action wave by wave in the order of the sync waves of the resources, as given by their "+common.AnnotationSyncWave+" annotation. A wave is only started once the action completed on all resources of the previous wave, and the remaining waves are not run once the action failed, unless --continue-on-error allows")
+	command.Flags().UintVar(&waveDelay, "wave-delay", 0, "Wait this many seconds between the sync waves when used with --post-sync")
+	command.Flags().BoolVar(&diffOnly, "diff-only", false, "Do not apply the action. Instead, submit it as a server side dry run and print a diff of each resource against the state the action would result in. Uses the diff tool of 'argocd app diff', which can be changed with KUBECTL_EXTERNAL_DIFF")
+	command.Flags().BoolVar(&failOnDiff, "fail-on-diff", false, "Exit with a non-zero code if --diff-only finds that the action would change any resource")
+	command.Flags().BoolVar(&checkDrift, "check-drift", false, "With --diff-only, warn about the resources the action would make diverge from their desired state in git, and print the changes the next sync would revert")
+	command.Flags().BoolVar(&saveLast, "save-last", false, "Save this invocation in the CLI config so that it can be re-executed with 'argocd app actions replay APPNAME'")
+
+	command.Run = func(c *cobra.Command, args []string) {
+		if err := validateFlags(command, runFlagRules); err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "%v", err)
+		}
+		if err := validateSourcePath(command); err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "%v", err)
+		}
+		var appName string
+		var actionName string
+		if len(projects) > 0 {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(exitCodeInvalidArgs)
+			}
+			actionName = args[0]
+			if !yes && !terminal.IsTerminal(int(os.Stdin.Fd())) {
+				fatalWithCode(exitCodeInvalidArgs, "--project requires a terminal to confirm, or --yes")
+			}
+		} else if appSelector != "" {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(exitCodeInvalidArgs)
+			}
+			actionName = args[0]
+			if _, err := labels.Parse(appSelector); err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "Invalid --app-selector '%s': %v", appSelector, err)
+			}
+			if !yes && !terminal.IsTerminal(int(os.Stdin.Fd())) {
+				fatalWithCode(exitCodeInvalidArgs, "--app-selector requires a terminal to confirm, or --yes")
+			}
+		} else if recursive {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(exitCodeInvalidArgs)
+			}
+			appName = args[0]
+			actionName = args[1]
+			if !yes && !terminal.IsTerminal(int(os.Stdin.Fd())) {
+				fatalWithCode(exitCodeInvalidArgs, "--recursive requires a terminal to confirm, or --yes")
+			}
+		} else if len(args) != 2 && !(interactive && len(args) == 1) {
+			c.HelpFunc()(c, args)
+			os.Exit(exitCodeInvalidArgs)
+		} else if len(args) == 2 {
+			appName = args[0]
+			actionName = args[1]
+		} else {
+			appName = args[0]
+			if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+				fatalWithCode(exitCodeInvalidArgs, "--interactive requires a terminal. Please specify the action explicitly")
+			}
+			if saveLast || onApplication {
+				fatalWithCode(exitCodeInvalidArgs, "--interactive without an action cannot be combined with --save-last or --on-application")
+			}
+		}
+		if confirmEach && !terminal.IsTerminal(int(os.Stdin.Fd())) {
+			fatalWithCode(exitCodeInvalidArgs, "--confirm-each requires a terminal")
+		}
+		if fromKubectl == "-" && (confirmEach || interactive) {
+			fatalWithCode(exitCodeInvalidArgs, "--from-kubectl - reads stdin, so it cannot be combined with --confirm-each or --interactive. Please pass a file instead")
+		}
+		if parallelism < 1 {
+			fatalWithCode(exitCodeInvalidArgs, "--parallel must be at least 1")
+		}
+		if chunkSize < 0 {
+			fatalWithCode(exitCodeInvalidArgs, "--chunk-size must not be negative")
+		}
+		if maxFailures < 0 {
+			fatalWithCode(exitCodeInvalidArgs, "--max-failures must not be negative")
+		}
+		if output != "" && output != "json" && output != "yaml" && output != "jsonl" {
+			fatalWithCode(exitCodeInvalidArgs, "Unsupported output format '%s'. One of: yaml, json, jsonl", output)
+		}
+		if output == "jsonl" && (groupResults || wait || postActionHealthCheck || observeEvents) {
+			fatalWithCode(exitCodeInvalidArgs, "--out jsonl cannot be combined with --group-results, --wait, --post-action-health-check or --observe-events, which do not print a record per resource")
+		}
+		if outputPlugin != "" {
+			// apart from the results being passed to the plugin, the command behaves as with --out json
+			output = "json"
+		}
+		if auditSink != "" {
+			if sinkURL, err := url.Parse(auditSink); err != nil || sinkURL.Scheme != "http" && sinkURL.Scheme != "https" || sinkURL.Host == "" {
+				fatalWithCode(exitCodeInvalidArgs, "Invalid --audit-sink '%s'. Expected an http or https URL", auditSink)
+			}
+		}
+		if onMissing != onMissingSkip && onMissing != onMissingFail {
+			fatalWithCode(exitCodeInvalidArgs, "Unsupported --on-missing value '%s'. One of: %s, %s", onMissing, onMissingSkip, onMissingFail)
+		}
+		var runAnnotationKey string
+		if annotateRun {
+			runAnnotationKey = annotateRunKey
+		}
+		var recordedCommand string
+		if record {
+			recordedCommand = recordedCommandLine(os.Args)
+		}
+		// the flags are captured before the profile and selector file set any, but the run is only saved once it succeeded
+		var lastRun *localconfig.ActionRun
+		if saveLast {
+			run := newLastActionRun(command, appName, actionName)
+			lastRun = &run
+		}
+		saveRun := func() {
+			if lastRun != nil {
+				saveLastActionRun(clientOpts, *lastRun)
+			}
+		}
+		if profile != "" {
+			applySelectorProfile(command, clientOpts, profile)
+		}
+		var selection *selectorFile
+		if selectorFilePath != "" {
+			var err error
+			selection, err = readSelectorFile(selectorFilePath)
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "Failed to load selector file: %v", err)
+			}
+			applySelectorFile(command, selection)
+		}
+		var kubectlObjs []kubectlObject
+		if fromKubectl != "" {
+			var err error
+			kubectlObjs, err = readKubectlList(fromKubectl, os.Stdin)
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "Failed to read --from-kubectl: %v", err)
+			}
+		}
+		labelSelector := parseSelector(selector)
+		var nsSelector labels.Selector
+		if namespaceSelector != "" {
+			nsSelector = parseSelector(namespaceSelector)
+		}
+		minAge, maxAgeDuration := parseAgeFlags(olderThan, maxAge)
+		var syncStatusCode argoappv1.SyncStatusCode
+		if syncStatus != "" {
+			syncStatusCode = parseSyncStatus(syncStatus)
+		}
+		var healthStatusCode argoappv1.HealthStatusCode
+		if health != "" {
+			healthStatusCode = parseHealthStatus(health)
+		}
+		var imagePattern *regexp.Regexp
+		if image != "" {
+			imagePattern = parseImagePattern(image)
+		}
+		var changedSince time.Time
+		if onlyIfChanged != "" {
+			var err error
+			changedSince, err = parseChangedSince(onlyIfChanged, time.Now())
+			if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "%v", err)
+			}
+		}
+
+		out, closeTee, err := teeOutput(os.Stdout, tee)
+		if err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "Failed to open --tee file: %v", err)
+		}
+		defer closeTee()
+		if output == "jsonl" {
+			// errors are still logged to stderr, but are also printed as records so that the output stays parseable
+			log.AddHook(newJSONLErrorHook(out))
+		}
+		acdClient := argocdclient.NewClientOrDie(clientOpts)
+		conn, appIf := acdClient.NewApplicationClientOrDie()
+		defer util.Close(conn)
+		appIf = withCallTimeout(withVerboseLogging(appIf, verbose), time.Duration(timeout)*time.Second)
+		appIf = withManagedResourcesTimeout(appIf, time.Duration(managedResourcesTimeout)*time.Second)
+		appIf = withActionHooks(appIf, beforeHook, afterHook, ignoreHookErrors)
+		ctx := context.Background()
+		if supportedOptions, ok := getServerRunOptions(ctx, acdClient); ok {
+			if messages := unsupportedServerFeatures(command, supportedOptions); len(messages) > 0 {
+				fatalWithCode(exitCodeInvalidArgs, "%s", strings.Join(messages, "; "))
+			}
+		}
+		limiter := newActionRateLimiter(qps)
+
+		if onApplication {
+			err := runApplicationAction(ctx, appIf, appName, actionName, applicationpkg.ResourceActionRunRequest{
+				RunAnnotationKey: runAnnotationKey,
+				RecordedCommand:  recordedCommand,
+				Reason:           reason,
+				ResourceVersion:  resourceVersion,
+			})
+			checkStatusError(err)
+			saveRun()
+			return
+		}
+
+		// managedResources returns the managed resources of the application which match the selectors that are
+		// applied before the action is resolved
+		managedResources := func(appName string) []*argoappv1.ResourceDiff {
+			resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
+			checkStatusError(err)
+			// the resource is looked up before any filtering, so that it does not need to match the selectors itself
+			if selectorFromResource != "" {
+				labelSelector = withResourceLabels(labelSelector, resources.Items, selectorFromResource, namespace)
+			}
+			if selection != nil {
+				resources.Items = filterBySelectorFile(resources.Items, selection)
+			}
+			if kubectlObjs != nil {
+				var unmanaged []kubectlObject
+				resources.Items, unmanaged = filterByKubectlList(resources.Items, kubectlObjs)
+				if len(unmanaged) > 0 {
+					var names []string
+					for _, obj := range unmanaged {
+						names = append(names, obj.String())
+					}
+					log.Warnf("Skipping %d of %d listed resources which are not managed by application '%s': %s", len(unmanaged), len(kubectlObjs), appName, strings.Join(names, ", "))
+				}
+			}
+			if nsSelector != nil {
+				resources.Items, err = filterByNamespaceSelector(resources.Items, nsSelector)
+				checkStatusError(err)
+			}
+			if minAge > 0 || maxAgeDuration > 0 {
+				resources.Items, err = filterByAge(resources.Items, time.Now(), minAge, maxAgeDuration)
+				checkStatusError(err)
+			}
+			if syncStatusCode != "" || healthStatusCode != "" {
+				app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
+				checkStatusError(err)
+				if syncStatusCode != "" {
+					resources.Items = filterBySyncStatus(resources.Items, app.Status.Resources, syncStatusCode)
+				}
+				if healthStatusCode != "" {
+					resources.Items = filterByHealthStatus(resources.Items, app.Status.Resources, healthStatusCode)
+				}
+			}
+			if imagePattern != nil {
+				resources.Items, err = filterByImage(resources.Items, imagePattern)
+				checkStatusError(err)
+			}
+			return resources.Items
+		}
+
+		if len(projects) > 0 || appSelector != "" || recursive {
+			var appNames []string
+			var scope string
+			var expected string
+			var err error
+			if recursive {
+				appNames, err = applicationTree(ctx, appIf, appName)
+				checkStatusError(err)
+				scope = fmt.Sprintf("the application tree of '%s'", appName)
+				expected = appName
+			} else if appSelector != "" {
+				appNames, err = selectorApplications(ctx, appIf, appSelector)
+				checkStatusError(err)
+				if len(appNames) == 0 {
+					fatalWithCode(exitCodeNoMatch, "No applications found matching selector '%s'", appSelector)
+				}
+				scope = fmt.Sprintf("selector '%s'", appSelector)
+				expected = appSelector
+			} else {
+				appNames, err = projectApplications(ctx, appIf, projects)
+				checkStatusError(err)
+				if len(appNames) == 0 {
+					fatalWithCode(exitCodeNoMatch, "No applications found in project(s) %s", strings.Join(projects, ", "))
+				}
+				scope = fmt.Sprintf("project(s) %s", strings.Join(projects, ", "))
+				expected = strings.Join(projects, ",")
+			}
+			if !yes && !confirmMultiAppRun(scope, expected, appNames, actionName, cli.PromptMessage) {
+				fatalWithCode(exitCodeInvalidArgs, "Confirmation failed. No actions were run")
+			}
+			results := runMultiAppActions(appNames, managedResources, actionName, namespace, resourceName, labelSelector, func(appName string, objs []*unstructured.Unstructured, action string) []actionResult {
+				return runResourceActions(ctx, appIf, applicationpkg.ResourceActionRunRequest{
+					Name:             &appName,
+					Action:           action,
+					RunAnnotationKey: runAnnotationKey,
+					RecordedCommand:  recordedCommand,
+					FieldManager:     fieldManager,
+					Reason:           reason,
+				}, objs, parallelism, chunkSize, limiter, false, 1)
+			})
+			if len(results) == 0 {
+				fatalWithCode(exitCodeNoMatch, "No matching resource found in the applications of %s", scope)
+			}
+			if auditSink != "" {
+				postAuditRecords(newAuditSinkClient(auditSinkTimeout), auditSink, newAuditRecords(results, appName, currentUsername(ctx, acdClient), time.Now()))
+			}
+			if outputPlugin != "" {
+				checkOutputPluginError(outputPlugin, runOutputPlugin(outputPlugin, results, out, os.Stderr))
+			} else if output != "" {
+				checkStatusError(printActionResults(out, results, output))
+			} else {
+				printMultiAppActionResults(out, results)
+			}
+			if failed := countFailedResults(results); failed > 0 {
+				fatalWithCode(actionFailureExitCode(results), "%d of %d actions failed", failed, len(results))
+			}
+			saveRun()
+			return
+		}
+
+		resources := &applicationpkg.ManagedResourcesResponse{Items: managedResources(appName)}
+
+		if actionName == "" {
+			objs := filterResources(command, resources.Items, "", parseKinds(kindArg), namespace, resourceName, labelSelector, false)
+			actionName, err = chooseResourceAction(ctx, appIf, appName, objs[0], cli.PromptChoice)
+			checkStatusError(err)
+		}
+
+		var group string
+		var kind string
+		var actionNameOnly string
+		// Backwards comparability for running resume actions
+		if actionName == "resume" && normalizeKind(kindArg) == "Rollout" {
+			group = "argoproj.io"
+			kind = "Rollout"
+			actionNameOnly = "resume"
+			commandTail := ""
+			if resourceName != "" {
+				commandTail += " --resource-name " + resourceName
+			}
+			if namespace != "" {
+				commandTail += " --namespace " + namespace
+			}
+			if all {
+				commandTail += " --all"
+			}
+			if !noDeprecationWarnings {
+				fmt.Fprintf(diagnosticOutput, "\nWarning: this syntax for running the \"resume\" action has been deprecated. Please run the action as\n\n\targocd app actions run %s argoproj.io/Rollout/resume%s\n\n", appName, commandTail)
+			}
+		} else {
+			group, kind, actionNameOnly, err = resolveActionName(resources.Items, actionName)
+			if _, ok := err.(noMatchError); ok {
+				fatalWithCode(exitCodeNoMatch, "%v", err)
+			} else if err != nil {
+				fatalWithCode(exitCodeInvalidArgs, "%v", err)
+			}
+		}
+
+		missing := missingResources(resources.Items, group, kind, namespace, resourceName, labelSelector)
+		if len(missing) > 0 {
+			var names []string
+			for _, res := range missing {
+				names = append(names, fmt.Sprintf("%s/%s/%s", res.Kind, res.Namespace, res.Name))
+			}
+			if onMissing == onMissingFail {
+				fatalWithCode(exitCodeNoMatch, "Matching resources do not exist in the cluster: %s", strings.Join(names, ", "))
+			}
+			log.Warnf("Skipping matching resources which do not exist in the cluster: %s", strings.Join(names, ", "))
+		}
+		if explain {
+			explainResourceSelection(os.Stderr, command, resources.Items, group, parseKinds(kind), namespace, resourceName, labelSelector)
+		}
+		filteredObjects := filterResources(command, resources.Items, group, parseKinds(kind), namespace, resourceName, labelSelector, all)
+		var unchanged []*unstructured.Unstructured
+		if onlyIfChanged != "" {
+			matched := len(filteredObjects)
+			filteredObjects, unchanged = filterUnchanged(filteredObjects, changedSince, annotateRunKey, fieldManager)
+			if len(unchanged) > 0 {
+				log.Infof("Skipped %d of %d resources which did not change since %s", len(unchanged), matched, onlyIfChanged)
+			}
+		}
+		var declined []*unstructured.Unstructured
+		if confirmEach {
+			filteredObjects, declined = confirmEachResource(filteredObjects, actionNameOnly, cli.AskToProceed)
+			if len(declined) > 0 {
+				var names []string
+				for _, obj := range declined {
+					names = append(names, fmt.Sprintf("%s/%s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName()))
+				}
+				log.Warnf("Skipped %d of %d resources which were declined: %s", len(declined), len(declined)+len(filteredObjects), strings.Join(names, ", "))
+			}
+		}
+		if snapshotFile != "" {
+			checkStatusError(writeSnapshot(snapshotFile, filteredObjects, showManagedFields))
+			log.Infof("Wrote the manifests of %d resources to %s", len(filteredObjects), snapshotFile)
+		}
+		started := time.Now()
+		// by default, the run stops after the first failure
+		failureLimit := 1
+		if continueOnError {
+			failureLimit = maxFailures
+		}
+		runReq := applicationpkg.ResourceActionRunRequest{
+			Name:              &appName,
+			Action:            actionNameOnly,
+			Revision:          revision,
+			RunAnnotationKey:  runAnnotationKey,
+			RecordedCommand:   recordedCommand,
+			FieldManager:      fieldManager,
+			DryRun:            diffOnly,
+			Reason:            reason,
+			ResourceVersion:   resourceVersion,
+			ReturnTargetState: outputObjects,
+		}
+		var results []actionResult
+		if postSync {
+			results = runResourceActionsByWave(ctx, appIf, runReq, filteredObjects, parallelism, chunkSize, limiter, failureLimit, time.Duration(waveDelay)*time.Second)
+		} else {
+			results = runResourceActions(ctx, appIf, runReq, filteredObjects, parallelism, chunkSize, limiter, stopOnFirstSuccess, failureLimit)
+		}
+		if continueOnError && maxFailures > 0 && len(results) < len(filteredObjects) {
+			log.Warnf("Aborted after the action failed on %d resources, the limit set by --max-failures. It was not run on the remaining %d of %d resources", countFailedResults(results), len(filteredObjects)-len(results), len(filteredObjects))
+		}
+		if auditSink != "" {
+			postAuditRecords(newAuditSinkClient(auditSinkTimeout), auditSink, newAuditRecords(results, appName, currentUsername(ctx, acdClient), time.Now()))
+		}
+		if diffOnly {
+			// the diff tool writes to stdout directly, so the diffs are not written to the output of --tee
+			changed, err := printActionResultDiffs(os.Stdout, results, diff.PrintDiff)
+			checkStatusError(err)
+			if checkDrift {
+				normalizer, err := newAppDiffNormalizer(ctx, appIf, acdClient, appName)
+				checkStatusError(err)
+				_, err = printActionResultDrift(os.Stdout, results, resources.Items, normalizer, diff.PrintDiff)
+				checkStatusError(err)
+			}
+			if failed := countFailedResults(results); failed > 0 {
+				for _, result := range results {
+					if result.Error != nil {
+						log.Warnf("Action failed on %s %s/%s: %s", result.Kind, result.Namespace, result.Name, formatStatusError(result.Error))
+					}
+				}
+				fatalWithCode(actionFailureExitCode(results), "%d of %d actions failed", failed, len(results))
+			}
+			if failOnDiff && changed > 0 {
+				fatalWithCode(exitCodeActionFailed, "The action would change %d of %d resources", changed, len(results))
+			}
+			saveRun()
+			return
+		}
+		if groupResults {
+			skipped := append(skippedActionResults(missing, filteredObjects, results, actionNameOnly, stopOnFirstSuccess), declinedActionResults(declined, actionNameOnly)...)
+			skipped = append(skipped, unchangedActionResults(unchanged, actionNameOnly, onlyIfChanged)...)
+			grouped := groupActionResults(results, skipped)
+			if outputPlugin != "" {
+				checkOutputPluginError(outputPlugin, runOutputPlugin(outputPlugin, grouped, out, os.Stderr))
+			} else {
+				checkStatusError(printActionResultsGrouped(out, grouped, output))
+			}
+		} else if outputPlugin != "" {
+			checkOutputPluginError(outputPlugin, runOutputPlugin(outputPlugin, results, out, os.Stderr))
+		} else if output != "" {
+			checkStatusError(printActionResults(out, results, output))
+		}
+		if stopOnFirstSuccess {
+			succeeded := firstSucceededResult(results)
+			if succeeded == nil {
+				if output == "" {
+					for _, result := range results {
+						log.Warnf("Action failed on %s %s/%s: %s", result.Kind, result.Namespace, result.Name, formatStatusError(result.Error))
+					}
+				}
+				fatalWithCode(exitCodeActionFailed, "The action did not succeed on any of the %d resources it was run on", len(results))
+			}
+			log.Infof("Action '%s' succeeded on %s %s/%s. It was not run on the remaining %d matching resources", actionNameOnly, succeeded.Kind, succeeded.Namespace, succeeded.Name, len(filteredObjects)-len(results))
+			if outputObjects {
+				checkStatusError(printResultingObjects(out, []actionResult{*succeeded}, showManagedFields))
+			} else if output == "" && !groupResults {
+				printActionResultsRevisions(out, []actionResult{*succeeded})
+			}
+		} else {
+			if output != "" {
+				if failed := countFailedResults(results); failed > 0 {
+					fatalWithCode(actionFailureExitCode(results), "%d of %d actions failed", failed, len(results))
+				}
+			} else if outputObjects {
+				checkStatusError(printResultingObjects(out, results, showManagedFields))
+			} else if !groupResults {
+				printActionResultsRevisions(out, results)
+			}
+			if continueOnError {
+				if failed := countFailedResults(results); failed > 0 {
+					if output == "" {
+						for _, result := range results {
+							if result.Error != nil {
+								log.Warnf("Action failed on %s %s/%s: %s", result.Kind, result.Namespace, result.Name, formatStatusError(result.Error))
+							}
+						}
+					}
+					fatalWithCode(actionFailureExitCode(results), "%d of %d actions failed", failed, len(results))
+				}
+			}
+			for _, result := range results {
+				if result.Error != nil {
+					fatalWithCode(actionFailureExitCode(results), "%s", formatStatusError(result.Error))
+				}
+			}
+		}
+		saveRun()
+		// the events and health of the resources are only printed to stdout along with the default output, so that the
+		// structured results stay parseable
+		diagnostics := out
+		if output != "" {
+			diagnostics = os.Stderr
+		}
+		if observeEvents {
+			observeCtx, cancel := context.WithTimeout(ctx, time.Duration(observeDuration)*time.Second)
+			err := observeActionEvents(observeCtx, diagnostics, appIf, appName, succeededObjects(filteredObjects, results), started, observeEventsInterval)
+			cancel()
+			if err != nil {
+				log.Warnf("Stopped printing events: %v", err)
+			}
+		}
+		if wait {
+			waitCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			if watchTimeout != 0 {
+				time.AfterFunc(time.Duration(watchTimeout)*time.Second, cancel)
+			}
+			app, err := waitOnActionResultsHealth(diagnosticOutput, acdClient.WatchApplicationWithRetry(waitCtx, appName), results, watchTimeout)
+			if app != nil {
+				printActionResultsHealth(diagnostics, app, results)
+			}
+			checkStatusError(err)
+		} else if postActionHealthCheck {
+			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, Refresh: getRefreshType(true, false)})
+			checkStatusError(err)
+			printActionResultsHealth(diagnostics, app, results)
+		}
+	}
+	return command
+}
+
+// projectApplications returns the sorted names of the applications in the given projects
+func projectApplications(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, projects []string) ([]string, error) {
+	apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, app := range argo.FilterByProjects(apps.Items, projects) {
+		names = append(names, app.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// selectorApplications returns the sorted names of the applications matching the label selector. The selector is
+// also matched by the client since the server lists all applications if it cannot parse the selector.
+func selectorApplications(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, selector string) ([]string, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+	apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, app := range apps.Items {
+		if parsed.Matches(labels.Set(app.Labels)) {
+			names = append(names, app.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// applicationTree returns the name of the application followed by the names of all applications it manages,
+// directly or through their children, in breadth first order. Each application is only visited once so that cycles
+// between applications do not recurse indefinitely.
+func applicationTree(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName string) ([]string, error) {
+	names := []string{appName}
+	visited := map[string]bool{appName: true}
+	for i := 0; i < len(names); i++ {
+		resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &names[i]})
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range resources.Items {
+			if res.Group != application.Group || res.Kind != application.ApplicationKind {
+				continue
+			}
+			if visited[res.Name] {
+				log.Warnf("Application '%s' is managed by '%s' but was already visited. Skipping it to avoid a cycle", res.Name, names[i])
+				continue
+			}
+			visited[res.Name] = true
+			names = append(names, res.Name)
+		}
+	}
+	return names, nil
+}
+
+// confirmMultiAppRun asks the user to confirm running the action on the applications in the given scope by typing
+// the expected value
+func confirmMultiAppRun(scope string, expected string, appNames []string, actionName string, prompt func(message, value string) string) bool {
+	message := fmt.Sprintf("WARNING: this runs '%s' on the matching resources of %d applications in %s: %s\nType '%s' to proceed", actionName, len(appNames), scope, strings.Join(appNames, ", "), expected)
+	return prompt(message, "") == expected
+}
+
+// runMultiAppActions runs the action on the resources of each of the applications which match the selectors.
+// Applications without any matching resource are skipped. No further applications are processed once an action
+// failed.
+func runMultiAppActions(appNames []string, managedResources func(appName string) []*argoappv1.ResourceDiff, actionName string, namespace, resourceName string, selector labels.Selector, run func(appName string, objs []*unstructured.Unstructured, action string) []actionResult) []actionResult {
+	var results []actionResult
+	for _, appName := range appNames {
+		resources := managedResources(appName)
+		group, kind, action, err := resolveActionName(resources, actionName)
+		if _, ok := err.(noMatchError); ok {
+			log.Debugf("Skipping application '%s': %v", appName, err)
+			continue
+		} else if err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "Application '%s': %v", appName, err)
+		}
+		liveObjs, err := liveObjects(resources)
+		checkStatusError(err)
+		var objs []*unstructured.Unstructured
+		for _, obj := range liveObjs {
+			if resourceMismatch(obj, true, group, []string{kind}, namespace, resourceName, selector) == "" {
+				objs = append(objs, obj.DeepCopy())
+			}
+		}
+		if len(objs) == 0 {
+			log.Debugf("Skipping application '%s': no matching resource found", appName)
+			continue
+		}
+		appResults := run(appName, objs, action)
+		for i := range appResults {
+			appResults[i].App = appName
+		}
+		results = append(results, appResults...)
+		if countFailedResults(appResults) > 0 {
+			break
+		}
+	}
+	return results
+}
+
+// chooseResourceAction lets the user choose one of the actions available on the object and returns its fully
+// qualified name
+func chooseResourceAction(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName string, obj *unstructured.Unstructured, choose func(message string, options []string) int) (string, error) {
+	gvk := obj.GroupVersionKind()
+	actions, err := appIf.ListResourceActions(ctx, &applicationpkg.ApplicationResourceRequest{
+		Name:         &appName,
+		Namespace:    obj.GetNamespace(),
+		ResourceName: obj.GetName(),
+		Group:        gvk.Group,
+		Kind:         gvk.Kind,
+	})
+	if err != nil {
+		return "", err
+	}
+	var names []string
+	for _, action := range actions.Actions {
+		if !action.Available {
+			continue
+		}
+		name := action.Name
+		if !strings.Contains(name, "/") {
+			name = gvk.Group + "/" + gvk.Kind + "/" + name
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("No actions are available on %s %s/%s", gvk.Kind, obj.GetNamespace(), obj.GetName())
+	}
+	return names[choose(fmt.Sprintf("Actions available on %s %s/%s:", gvk.Kind, obj.GetNamespace(), obj.GetName()), names)], nil
+}
+
+// runApplicationAction runs an action registered for the Application kind on the Application resource itself. The
+// action may be given either fully qualified, i.e. argoproj.io/Application/ACTION, or by its name only. The options
+// of the run, e.g. the reason for it, are taken from req.
+func runApplicationAction(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName string, actionName string, req applicationpkg.ResourceActionRunRequest) error {
+	gvk := argoappv1.ApplicationSchemaGroupVersionKind
+	actionNameOnly := actionName
+	if strings.Contains(actionName, "/") {
+		var group, kind string
+		group, kind, actionNameOnly = parseActionName(actionName)
+		if group != gvk.Group || kind != gvk.Kind {
+			return fmt.Errorf("Action '%s' is not an action on %s/%s", actionName, gvk.Group, gvk.Kind)
+		}
+	}
+	req.Name = &appName
+	req.Group = gvk.Group
+	req.Kind = gvk.Kind
+	req.Version = gvk.Version
+	req.ResourceName = appName
+	req.Action = actionNameOnly
+	_, err := appIf.RunResourceAction(ctx, &req)
+	return err
+}
+
+// observeEventsInterval is the interval at which the events of the affected resources are polled by --observe-events
+var observeEventsInterval = 2 * time.Second
+
+// succeededObjects returns the objects the action succeeded on
+func succeededObjects(objs []*unstructured.Unstructured, results []actionResult) []*unstructured.Unstructured {
+	succeeded := make(map[string]bool)
+	for _, result := range results {
+		if result.Error == nil {
+			succeeded[result.Group+"/"+result.Kind+"/"+result.Namespace+"/"+result.Name] = true
+		}
+	}
+	var res []*unstructured.Unstructured
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		if succeeded[gvk.Group+"/"+gvk.Kind+"/"+obj.GetNamespace()+"/"+obj.GetName()] {
+			res = append(res, obj)
+		}
+	}
+	return res
+}
+
+// eventTime returns the time an event last occurred
+func eventTime(event corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.FirstTimestamp.Time
+}
+
+// observeActionEvents polls the events of the objects at the given interval until the context is done and prints
+// each event which occurred since the given time as it is seen, ordered by time. Events which recur are printed
+// again each time their count increases.
+func observeActionEvents(ctx context.Context, w io.Writer, appIf applicationpkg.ApplicationServiceClient, appName string, objs []*unstructured.Unstructured, since time.Time, interval time.Duration) error {
+	// event timestamps only have a precision of seconds
+	since = since.Truncate(time.Second)
+	printed := make(map[string]bool)
+	for {
+		var events []corev1.Event
+		for _, obj := range objs {
+			list, err := appIf.ListResourceEvents(ctx, &applicationpkg.ApplicationResourceEventsQuery{
+				Name:              &appName,
+				ResourceNamespace: obj.GetNamespace(),
+				ResourceName:      obj.GetName(),
+				ResourceUID:       string(obj.GetUID()),
+			})
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			for _, event := range list.Items {
+				key := fmt.Sprintf("%s/%d", event.UID, event.Count)
+				if printed[key] || eventTime(event).Before(since) {
+					continue
+				}
+				printed[key] = true
+				events = append(events, event)
+			}
+		}
+		sort.SliceStable(events, func(i, j int) bool {
+			return eventTime(events[i]).Before(eventTime(events[j]))
+		})
+		for _, event := range events {
+			obj := event.InvolvedObject
+			fmt.Fprintf(w, "%s %s %s/%s %s %s: %s\n", eventTime(event).Format(time.RFC3339), obj.Kind, obj.Namespace, obj.Name, event.Type, event.Reason, event.Message)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// waitOnActionResultsHealth consumes application watch events until all resources the action succeeded on are
+// healthy or suspended, printing each health transition of those resources as it is observed. It fails once a
+// resource becomes degraded, or with a timeout error when the event channel is closed before.
+func waitOnActionResultsHealth(w io.Writer, appEventCh <-chan *argoappv1.ApplicationWatchEvent, results []actionResult, timeout uint) (*argoappv1.Application, error) {
+	var keys []string
+	prevHealth := make(map[string]argoappv1.HealthStatusCode)
+	for _, result := range results {
+		if result.Error == nil {
+			key := result.Group + "/" + result.Kind + "/" + result.Namespace + "/" + result.Name
+			keys = append(keys, key)
+			prevHealth[key] = ""
+		}
+	}
+	var app *argoappv1.Application
+	for appEvent := range appEventCh {
+		app = &appEvent.Application
+		health := make(map[string]argoappv1.HealthStatusCode)
+		for _, res := range app.Status.Resources {
+			if res.Health != nil {
+				health[res.Group+"/"+res.Kind+"/"+res.Namespace+"/"+res.Name] = res.Health.Status
+			}
+		}
+		ready := true
+		for _, key := range keys {
+			status, ok := health[key]
+			if !ok {
+				status = argoappv1.HealthStatusUnknown
+			}
+			if prev := prevHealth[key]; prev != status {
+				parts := strings.SplitN(key, "/", 4)
+				if prev == "" {
+					fmt.Fprintf(w, "resource %s %s/%s: %s\n", parts[1], parts[2], parts[3], status)
+				} else {
+					fmt.Fprintf(w, "resource %s %s/%s: %s -> %s\n", parts[1], parts[2], parts[3], prev, status)
+				}
+				if prev != "" && status == argoappv1.HealthStatusDegraded {
+					return app, fmt.Errorf("Resource %s %s/%s health has transitioned from %s to %s", parts[1], parts[2], parts[3], prev, status)
+				}
+				prevHealth[key] = status
+			}
+			if status != argoappv1.HealthStatusHealthy && status != argoappv1.HealthStatusSuspended {
+				ready = false
+			}
+		}
+		if ready {
+			return app, nil
+		}
+	}
+	var pending []string
+	for _, key := range keys {
+		if status := prevHealth[key]; status != argoappv1.HealthStatusHealthy && status != argoappv1.HealthStatusSuspended {
+			if status == "" {
+				status = argoappv1.HealthStatusUnknown
+			}
+			parts := strings.SplitN(key, "/", 4)
+			pending = append(pending, fmt.Sprintf("%s %s/%s (%s)", parts[1], parts[2], parts[3], status))
+		}
+	}
+	return app, fmt.Errorf("Timed out (%ds) waiting for resources to become healthy: %s", timeout, strings.Join(pending, ", "))
+}
+
+const (
+	onMissingSkip = "skip"
+	onMissingFail = "fail"
+)
+
+// missingResources returns the managed resources matching the selectors which have no live object. Since there
+// is no live object, the label selector is matched against the labels of the target object.
+func missingResources(resources []*argoappv1.ResourceDiff, group, kind, namespace, resourceName string, selector labels.Selector) []*argoappv1.ResourceDiff {
+	kind = normalizeKind(kind)
+	var missing []*argoappv1.ResourceDiff
+	for _, res := range resources {
+		if res.LiveState != "" && res.LiveState != "null" {
+			continue
+		}
+		if res.Group != group || res.Kind != kind {
+			continue
+		}
+		if namespace != "" && namespace != res.Namespace {
+			continue
+		}
+		if resourceName != "" && resourceName != res.Name {
+			continue
+		}
+		target, err := res.TargetObject()
+		checkStatusError(err)
+		if target != nil && !selector.Matches(labels.Set(target.GetLabels())) {
+			continue
+		}
+		missing = append(missing, res)
+	}
+	return missing
+}
+
+func parseActionName(action string) (string, string, string) {
+	actionSplit := strings.Split(action, "/")
+	if len(actionSplit) != 3 {
+		fatalWithCode(exitCodeInvalidArgs, "Action name is malformed")
+	}
+	return actionSplit[0], actionSplit[1], actionSplit[2]
+}
+
+// resolveActionName splits the action name into group, kind and action. Besides the GROUP/KIND/ACTION form, the
+// KIND/ACTION form is supported, in which case the group is resolved from the managed resources of that kind.
+func resolveActionName(resources []*argoappv1.ResourceDiff, action string) (string, string, string, error) {
+	actionSplit := strings.Split(action, "/")
+	if len(actionSplit) != 2 {
+		group, kind, actionName := parseActionName(action)
+		return group, kind, actionName, nil
+	}
+	kind := normalizeKind(actionSplit[0])
+	seen := make(map[string]bool)
+	var groups []string
+	for _, res := range resources {
+		if res.Kind == kind && !seen[res.Group] {
+			seen[res.Group] = true
+			groups = append(groups, res.Group)
+		}
+	}
+	switch len(groups) {
+	case 0:
+		return "", "", "", noMatchError{fmt.Errorf("No managed resources of kind '%s' to resolve the group of action '%s' from", kind, action)}
+	case 1:
+		return groups[0], kind, actionSplit[1], nil
+	}
+	sort.Strings(groups)
+	return "", "", "", fmt.Errorf("Kind '%s' is defined by multiple groups: %s. Please specify the action as GROUP/KIND/ACTION", kind, strings.Join(groups, ", "))
+}
+
+// confirmEachResource asks for confirmation to run the action on each of the objects and returns the confirmed and
+// declined objects
+func confirmEachResource(objs []*unstructured.Unstructured, action string, ask func(message string) bool) ([]*unstructured.Unstructured, []*unstructured.Unstructured) {
+	var confirmed, declined []*unstructured.Unstructured
+	for i, obj := range objs {
+		if ask(fmt.Sprintf("[%d/%d] Run '%s' on %s %s/%s (y/n)? ", i+1, len(objs), action, obj.GetKind(), obj.GetNamespace(), obj.GetName())) {
+			confirmed = append(confirmed, obj)
+		} else {
+			declined = append(declined, obj)
+		}
+	}
+	return confirmed, declined
+}
+
+// currentUsername returns the name of the user the client is logged in as, or an empty string if it cannot be
+// determined
+func currentUsername(ctx context.Context, acdClient argocdclient.Client) string {
+	conn, sessionIf, err := acdClient.NewSessionClient()
+	if err != nil {
+		log.Warnf("Failed to determine the current user for the audit records: %v", err)
+		return ""
+	}
+	defer util.Close(conn)
+	userInfo, err := sessionIf.GetUserInfo(ctx, &sessionpkg.GetUserInfoRequest{})
+	if err != nil {
+		log.Warnf("Failed to determine the current user for the audit records: %v", formatStatusError(err))
+		return ""
+	}
+	return userInfo.Username
+}
+
+// actionResults collects the results of actions which are run concurrently
+type actionResults struct {
+	lock      sync.Mutex
+	results   []actionResult
+	failed    int
+	succeeded bool
+	// stopOnSuccess stops the run after the first successful action rather than after maxFailures failed ones
+	stopOnSuccess bool
+	// maxFailures is the number of failed actions after which the run is stopped, or 0 to never stop on failures
+	maxFailures int
+}
+
+func (r *actionResults) add(result actionResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.results = append(r.results, result)
+	if result.Error != nil {
+		r.failed++
+	} else {
+		r.succeeded = true
+	}
+}
+
+// stopped returns true if no further actions should be started
+func (r *actionResults) stopped() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.stopOnSuccess {
+		return r.succeeded
+	}
+	return r.maxFailures > 0 && r.failed >= r.maxFailures
+}
+
+// sorted returns a copy of the collected results in a stable order, regardless of the order in which they were added
+func (r *actionResults) sorted() []actionResult {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	results := make([]actionResult, len(r.results))
+	copy(results, r.results)
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return results
+}
+
+// defaultActionQPS is the default maximum rate at which actions are run
+const defaultActionQPS = 10
+
+// newActionRateLimiter returns a limiter allowing qps action calls per second across all workers. A qps of zero
+// or less disables rate limiting.
+func newActionRateLimiter(qps float64) *rate.Limiter {
+	if qps <= 0 {
+		return rate.NewLimiter(rate.Inf, 1)
+	}
+	return rate.NewLimiter(rate.Limit(qps), 1)
+}
+
+// runResourceActions runs the action described by the given request on each of the objects, using up to
+// parallelism concurrent calls which are throttled by the limiter. If chunkSize is positive, the action is run on
+// up to chunkSize objects with a single call. No further actions are started once maxFailures actions have failed,
+// unless maxFailures is 0, or, if stopOnSuccess is set, once an action has succeeded.
+func runResourceActions(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, req applicationpkg.ResourceActionRunRequest, objs []*unstructured.Unstructured, parallelism int, chunkSize int, limiter *rate.Limiter, stopOnSuccess bool, maxFailures int) []actionResult {
+	results := &actionResults{stopOnSuccess: stopOnSuccess, maxFailures: maxFailures}
+	chunksCh := make(chan []*unstructured.Unstructured)
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range chunksCh {
+				if results.stopped() {
+					continue
+				}
+				if err := limiter.Wait(ctx); err != nil {
+					for _, obj := range chunk {
+						results.add(newActionResult(obj, req.Action, err))
+					}
+					continue
+				}
+				if chunkSize > 0 {
+					for _, result := range runResourceActionsBatch(ctx, appIf, req, chunk) {
+						results.add(result)
+					}
+					continue
+				}
+				obj := chunk[0]
+				gvk := obj.GroupVersionKind()
+				objReq := req
+				objReq.Namespace = obj.GetNamespace()
+				objReq.ResourceName = obj.GetName()
+				objReq.Group = gvk.Group
+				objReq.Kind = gvk.Kind
+				res, err := appIf.RunResourceAction(ctx, &objReq)
+				result := newActionResult(obj, req.Action, err)
+				if res != nil {
+					result.ResourceRevision = res.ResourceRevision
+					result.Generation = res.Generation
+					result.LiveState = res.LiveState
+					result.TargetState = res.TargetState
+				}
+				results.add(result)
+			}
+		}()
+	}
+	size := chunkSize
+	if size <= 0 {
+		size = 1
+	}
+	for start := 0; start < len(objs); start += size {
+		if results.stopped() {
+			break
+		}
+		end := start + size
+		if end > len(objs) {
+			end = len(objs)
+		}
+		chunksCh <- objs[start:end]
+	}
+	close(chunksCh)
+	wg.Wait()
+	return results.sorted()
+}
+
+// runResourceActionsByWave runs the action on the objects wave by wave, in ascending order of their sync waves, waiting
+// for the delay between waves. The next wave is only started once the action completed on all objects of the previous
+// one, and no further waves are started once the action failed on maxFailures objects, unless maxFailures is 0.
+func runResourceActionsByWave(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, req applicationpkg.ResourceActionRunRequest, objs []*unstructured.Unstructured, parallelism int, chunkSize int, limiter *rate.Limiter, maxFailures int, delay time.Duration) []actionResult {
+	waveObjs := make(map[int][]*unstructured.Unstructured)
+	var waves []int
+	for _, obj := range objs {
+		wave := syncwaves.Wave(obj)
+		if _, ok := waveObjs[wave]; !ok {
+			waves = append(waves, wave)
+		}
+		waveObjs[wave] = append(waveObjs[wave], obj)
+	}
+	sort.Ints(waves)
+	var results []actionResult
+	failed := 0
+	for i, wave := range waves {
+		if i > 0 && delay > 0 {
+			log.Infof("Waiting %v before sync wave %d", delay, wave)
+			time.Sleep(delay)
+		}
+		log.Infof("Running action '%s' on %d resources of sync wave %d", req.Action, len(waveObjs[wave]), wave)
+		remainingFailures := 0
+		if maxFailures > 0 {
+			remainingFailures = maxFailures - failed
+		}
+		waveResults := runResourceActions(ctx, appIf, req, waveObjs[wave], parallelism, chunkSize, limiter, false, remainingFailures)
+		results = append(results, waveResults...)
+		failed += countFailedResults(waveResults)
+		if maxFailures > 0 && failed >= maxFailures {
+			break
+		}
+	}
+	return results
+}
+
+// runResourceActionsBatch runs the action described by the given request on all of the objects with a single call
+// and returns the result reported for each of them
+func runResourceActionsBatch(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, req applicationpkg.ResourceActionRunRequest, objs []*unstructured.Unstructured) []actionResult {
+	batchReq := applicationpkg.ResourceActionsRunRequest{
+		Name:              req.Name,
+		Action:            req.Action,
+		Revision:          req.Revision,
+		RunAnnotationKey:  req.RunAnnotationKey,
+		RecordedCommand:   req.RecordedCommand,
+		FieldManager:      req.FieldManager,
+		Reason:            req.Reason,
+		ReturnTargetState: req.ReturnTargetState,
+		DryRun:            req.DryRun,
+	}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		batchReq.Targets = append(batchReq.Targets, applicationpkg.ResourceActionTarget{
+			Namespace:       obj.GetNamespace(),
+			ResourceName:    obj.GetName(),
+			Version:         gvk.Version,
+			Group:           gvk.Group,
+			Kind:            gvk.Kind,
+			ResourceVersion: req.ResourceVersion,
+		})
+	}
+	var results []actionResult
+	res, err := appIf.RunResourceActions(ctx, &batchReq)
+	if err != nil {
+		for _, obj := range objs {
+			results = append(results, newActionResult(obj, req.Action, err))
+		}
+		return results
+	}
+	targetResults := make(map[string]applicationpkg.ResourceActionRunResult)
+	for _, result := range res.Results {
+		targetResults[result.Target.Group+"/"+result.Target.Kind+"/"+result.Target.Namespace+"/"+result.Target.ResourceName] = result
+	}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		var err error
+		targetResult, ok := targetResults[gvk.Group+"/"+gvk.Kind+"/"+obj.GetNamespace()+"/"+obj.GetName()]
+		if !ok {
+			err = fmt.Errorf("no result was reported for the resource")
+		} else if targetResult.Error != "" {
+			err = fmt.Errorf("%s", targetResult.Error)
+		}
+		result := newActionResult(obj, req.Action, err)
+		result.Reason = targetResult.Reason
+		result.ResourceRevision = targetResult.ResourceRevision
+		result.Generation = targetResult.Generation
+		result.TargetState = targetResult.TargetState
+		result.LiveState = targetResult.LiveState
+		results = append(results, result)
+	}
+	return results
+}
+
+// newActionResult returns the result of running the action on the object
+func newActionResult(obj *unstructured.Unstructured, action string, err error) actionResult {
+	gvk := obj.GroupVersionKind()
+	return actionResult{
+		Group:     gvk.Group,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Action:    action,
+		Error:     err,
+		Reason:    applicationpkg.ActionFailureReason(err),
+	}
+}
@@ -0,0 +1,497 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/common"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestRunResourceActionsParallel(t *testing.T) {
+	var objs []*unstructured.Unstructured
+	for i := 0; i < 50; i++ {
+		objs = append(objs, newDeployment("default", fmt.Sprintf("deploy-%02d", i)))
+	}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{}
+	results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 8, 0, newActionRateLimiter(0), false, 1)
+
+	assert.Len(t, client.requests, len(objs))
+	if assert.Len(t, results, len(objs)) {
+		for i, result := range results {
+			assert.Equal(t, fmt.Sprintf("deploy-%02d", i), result.Name)
+			assert.Equal(t, "apps", result.Group)
+			assert.Equal(t, "Deployment", result.Kind)
+			assert.Equal(t, "restart", result.Action)
+			assert.NoError(t, result.Error)
+		}
+	}
+	for _, req := range client.requests {
+		assert.Equal(t, appName, *req.Name)
+		assert.Equal(t, "restart", req.Action)
+	}
+}
+
+func TestRunResourceActionsRateLimited(t *testing.T) {
+	var objs []*unstructured.Unstructured
+	for i := 0; i < 5; i++ {
+		objs = append(objs, newDeployment("default", fmt.Sprintf("deploy-%02d", i)))
+	}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{}
+	start := time.Now()
+	results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 5, 0, newActionRateLimiter(20), false, 1)
+
+	// the first call is made immediately and each of the remaining calls waits for 1/20s regardless of parallelism
+	assert.True(t, time.Since(start) >= 190*time.Millisecond, "actions were not rate limited")
+	assert.Len(t, client.requests, len(objs))
+	for _, result := range results {
+		assert.NoError(t, result.Error)
+	}
+}
+
+func TestRunResourceActionsStopsOnFailure(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "a"), newDeployment("default", "b"), newDeployment("default", "c")}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{runErr: func(req *applicationpkg.ResourceActionRunRequest) error {
+		if req.ResourceName == "a" {
+			return fmt.Errorf("boom")
+		}
+		return nil
+	}}
+	results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), false, 1)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, "a", results[0].Name)
+		assert.EqualError(t, results[0].Error, "boom")
+	}
+}
+
+func TestRunResourceActionsMaxFailures(t *testing.T) {
+	var objs []*unstructured.Unstructured
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		objs = append(objs, newDeployment("default", name))
+	}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{runErr: func(req *applicationpkg.ResourceActionRunRequest) error {
+		if req.ResourceName != "c" {
+			return fmt.Errorf("boom")
+		}
+		return nil
+	}}
+	// the run is aborted once the action failed on two resources
+	results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), false, 2)
+	assert.Len(t, results, 2)
+	assert.Equal(t, 2, countFailedResults(results))
+
+	results = runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), false, 3)
+	assert.Len(t, results, 4)
+	assert.Equal(t, 3, countFailedResults(results))
+
+	// without a limit, the action is run on all resources
+	results = runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), false, 0)
+	assert.Len(t, results, 5)
+	assert.Equal(t, 4, countFailedResults(results))
+}
+
+func TestRunResourceActionsStopsOnFirstSuccess(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "a"), newDeployment("default", "b"), newDeployment("default", "c")}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{runErr: func(req *applicationpkg.ResourceActionRunRequest) error {
+		if req.ResourceName == "a" {
+			return fmt.Errorf("boom")
+		}
+		return nil
+	}}
+	results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), true, 1)
+	// the failure on a does not stop the run, but the success on b does
+	if assert.Len(t, results, 2) {
+		assert.EqualError(t, results[0].Error, "boom")
+		assert.Equal(t, "b", firstSucceededResult(results).Name)
+	}
+	skipped := skippedActionResults(nil, objs, results, "restart", true)
+	if assert.Len(t, skipped, 1) {
+		assert.Equal(t, "c", skipped[0].Name)
+		assert.EqualError(t, skipped[0].Error, "action was not run since it already succeeded on another resource")
+	}
+
+	assert.Nil(t, firstSucceededResult(results[:1]))
+}
+
+func TestMissingResources(t *testing.T) {
+	live := newResourceDiff("apps", "v1", "Deployment", "default", "guestbook-ui")
+	missing := &argoappv1.ResourceDiff{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-api", LiveState: "null"}
+	otherKind := &argoappv1.ResourceDiff{Group: "", Kind: "Service", Namespace: "default", Name: "guestbook-api"}
+	resources := []*argoappv1.ResourceDiff{live, missing, otherKind}
+
+	assert.Equal(t, []*argoappv1.ResourceDiff{missing}, missingResources(resources, "apps", "deployments", "", "", labels.Everything()))
+	assert.Equal(t, []*argoappv1.ResourceDiff{missing}, missingResources(resources, "apps", "Deployment", "default", "guestbook-api", labels.Everything()))
+	assert.Empty(t, missingResources(resources, "apps", "Deployment", "", "guestbook-ui", labels.Everything()))
+	assert.Empty(t, missingResources(resources, "apps", "Deployment", "other", "", labels.Everything()))
+}
+
+func TestRunResourceActionsByWave(t *testing.T) {
+	withWave := func(obj *unstructured.Unstructured, wave string) *unstructured.Unstructured {
+		obj.SetAnnotations(map[string]string{common.AnnotationSyncWave: wave})
+		return obj
+	}
+	objs := []*unstructured.Unstructured{
+		withWave(newDeployment("default", "a"), "2"),
+		newDeployment("default", "b"),
+		withWave(newDeployment("default", "c"), "-1"),
+		withWave(newDeployment("default", "d"), "2"),
+	}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{}
+	results := runResourceActionsByWave(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 2, 0, newActionRateLimiter(0), 1, 0)
+	var names []string
+	for _, result := range results {
+		names = append(names, result.Name)
+	}
+	assert.Equal(t, []string{"c", "b", "a", "d"}, names)
+	// a wave is only started once the previous one completed
+	if assert.Len(t, client.requests, 4) {
+		assert.Equal(t, "c", client.requests[0].ResourceName)
+		assert.Equal(t, "b", client.requests[1].ResourceName)
+	}
+
+	// the remaining waves are not run after a failure
+	client = &fakeAppServiceClient{runErr: func(req *applicationpkg.ResourceActionRunRequest) error {
+		if req.ResourceName == "b" {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	}}
+	results = runResourceActionsByWave(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), 1, 0)
+	assert.Len(t, results, 2)
+	assert.Equal(t, 1, countFailedResults(results))
+
+	// unless failures are allowed
+	results = runResourceActionsByWave(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 0, newActionRateLimiter(0), 0, 0)
+	assert.Len(t, results, 4)
+}
+
+func TestRunApplicationAction(t *testing.T) {
+	for _, actionName := range []string{"refresh", "argoproj.io/Application/refresh"} {
+		appIf := &fakeAppServiceClient{}
+		assert.NoError(t, runApplicationAction(context.Background(), appIf, "guestbook", actionName, applicationpkg.ResourceActionRunRequest{Reason: "INC-1234", ResourceVersion: "123"}))
+		if assert.Len(t, appIf.requests, 1) {
+			req := appIf.requests[0]
+			assert.Equal(t, "guestbook", *req.Name)
+			assert.Equal(t, "argoproj.io", req.Group)
+			assert.Equal(t, "Application", req.Kind)
+			assert.Equal(t, "v1alpha1", req.Version)
+			assert.Equal(t, "guestbook", req.ResourceName)
+			assert.Equal(t, "refresh", req.Action)
+			assert.Equal(t, "INC-1234", req.Reason)
+			assert.Equal(t, "123", req.ResourceVersion)
+		}
+	}
+
+	appIf := &fakeAppServiceClient{}
+	assert.Error(t, runApplicationAction(context.Background(), appIf, "guestbook", "apps/Deployment/restart", applicationpkg.ResourceActionRunRequest{}))
+	assert.Empty(t, appIf.requests)
+}
+
+func TestObserveActionEvents(t *testing.T) {
+	started := time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC)
+	newEvent := func(uid string, name string, reason string, at time.Time) corev1.Event {
+		return corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{UID: types.UID(uid)},
+			InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Namespace: "default", Name: name},
+			Type:           corev1.EventTypeNormal,
+			Reason:         reason,
+			Message:        "Scaled up replica set",
+			Count:          1,
+			LastTimestamp:  metav1.NewTime(at),
+		}
+	}
+	client := &fakeAppServiceClient{events: map[string][]corev1.Event{
+		"a": {newEvent("1", "a", "Old", started.Add(-time.Minute)), newEvent("2", "a", "ScalingReplicaSet", started.Add(2*time.Second))},
+		"b": {newEvent("3", "b", "ScalingReplicaSet", started.Add(time.Second))},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var out bytes.Buffer
+	objs := []*unstructured.Unstructured{newDeployment("default", "a"), newDeployment("default", "b")}
+	assert.NoError(t, observeActionEvents(ctx, &out, client, "guestbook", objs, started, time.Millisecond))
+	// the events are polled repeatedly, but each event is only printed once
+	assert.True(t, client.eventCalls > 2)
+	assert.Equal(t, "2019-07-01T12:00:01Z Deployment default/b Normal ScalingReplicaSet: Scaled up replica set\n"+
+		"2019-07-01T12:00:02Z Deployment default/a Normal ScalingReplicaSet: Scaled up replica set\n", out.String())
+}
+
+func TestSucceededObjects(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "a"), newDeployment("default", "b")}
+	results := []actionResult{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "a", Error: fmt.Errorf("boom")},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "b"},
+	}
+	assert.Equal(t, objs[1:], succeededObjects(objs, results))
+}
+
+func TestUnsupportedServerFeatures(t *testing.T) {
+	command := NewApplicationResourceActionsRunCommand(&argocdclient.ClientOptions{})
+	assert.NoError(t, command.Flags().Set("revision", "HEAD"))
+
+	assert.Equal(t, []string{"--revision is not supported by the server, which does not support the 'revision' run option"}, unsupportedServerFeatures(command, nil))
+	assert.Equal(t, []string{"--revision is not supported by the server, which does not support the 'revision' run option"}, unsupportedServerFeatures(command, []string{"dryRun"}))
+	assert.Empty(t, unsupportedServerFeatures(command, []string{"dryRun", "revision"}))
+	assert.Empty(t, unsupportedServerFeatures(NewApplicationResourceActionsRunCommand(&argocdclient.ClientOptions{}), nil))
+}
+
+func TestWaitOnActionResultsHealth(t *testing.T) {
+	newEvent := func(health ...argoappv1.HealthStatusCode) *argoappv1.ApplicationWatchEvent {
+		event := &argoappv1.ApplicationWatchEvent{}
+		for i, status := range health {
+			event.Application.Status.Resources = append(event.Application.Status.Resources, argoappv1.ResourceStatus{
+				Group: "apps", Kind: "Deployment", Namespace: "default", Name: fmt.Sprintf("deploy-%d", i), Health: &argoappv1.HealthStatus{Status: status},
+			})
+		}
+		return event
+	}
+	results := []actionResult{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "deploy-0", Action: "restart"},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "deploy-1", Action: "restart"},
+	}
+	events := func(events ...*argoappv1.ApplicationWatchEvent) chan *argoappv1.ApplicationWatchEvent {
+		ch := make(chan *argoappv1.ApplicationWatchEvent, len(events))
+		for _, event := range events {
+			ch <- event
+		}
+		close(ch)
+		return ch
+	}
+
+	var buf bytes.Buffer
+	app, err := waitOnActionResultsHealth(&buf, events(
+		newEvent(argoappv1.HealthStatusProgressing, argoappv1.HealthStatusHealthy),
+		newEvent(argoappv1.HealthStatusProgressing, argoappv1.HealthStatusHealthy),
+		newEvent(argoappv1.HealthStatusHealthy, argoappv1.HealthStatusHealthy),
+	), results, 0)
+	assert.NoError(t, err)
+	assert.NotNil(t, app)
+	assert.Equal(t, `resource Deployment default/deploy-0: Progressing
+resource Deployment default/deploy-1: Healthy
+resource Deployment default/deploy-0: Progressing -> Healthy
+`, buf.String())
+
+	buf.Reset()
+	_, err = waitOnActionResultsHealth(&buf, events(
+		newEvent(argoappv1.HealthStatusProgressing, argoappv1.HealthStatusHealthy),
+	), results, 30)
+	assert.EqualError(t, err, "Timed out (30s) waiting for resources to become healthy: Deployment default/deploy-0 (Progressing)")
+
+	buf.Reset()
+	_, err = waitOnActionResultsHealth(&buf, events(
+		newEvent(argoappv1.HealthStatusProgressing, argoappv1.HealthStatusHealthy),
+		newEvent(argoappv1.HealthStatusDegraded, argoappv1.HealthStatusHealthy),
+	), results, 0)
+	assert.EqualError(t, err, "Resource Deployment default/deploy-0 health has transitioned from Progressing to Degraded")
+}
+
+func TestRunProjectActions(t *testing.T) {
+	managed := map[string][]*argoappv1.ResourceDiff{
+		"frontend": {
+			newResourceDiff("argoproj.io", "v1alpha1", "Rollout", "default", "frontend"),
+			newResourceDiff("apps", "v1", "Deployment", "default", "frontend-cache"),
+		},
+		"backend": {
+			newResourceDiff("argoproj.io", "v1alpha1", "Rollout", "prod", "backend"),
+		},
+		"configs": {
+			newResourceDiff("", "v1", "ConfigMap", "default", "config"),
+		},
+	}
+	var ran []string
+	run := func(appName string, objs []*unstructured.Unstructured, action string) []actionResult {
+		var results []actionResult
+		for _, obj := range objs {
+			ran = append(ran, appName+"/"+obj.GetName())
+			var err error
+			if obj.GetName() == "frontend" {
+				err = fmt.Errorf("boom")
+			}
+			results = append(results, newActionResult(obj, action, err))
+		}
+		return results
+	}
+	managedResources := func(appName string) []*argoappv1.ResourceDiff { return managed[appName] }
+
+	results := runMultiAppActions([]string{"backend", "configs", "frontend"}, managedResources, "Rollout/pause", "", "", labels.Everything(), run)
+	assert.Equal(t, []string{"backend/backend", "frontend/frontend"}, ran)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, "backend", results[0].App)
+		assert.Equal(t, "pause", results[0].Action)
+		assert.Equal(t, "frontend", results[1].App)
+		assert.EqualError(t, results[1].Error, "boom")
+	}
+
+	// no further applications are processed after a failure
+	ran = nil
+	runMultiAppActions([]string{"frontend", "backend"}, managedResources, "Rollout/pause", "", "", labels.Everything(), run)
+	assert.Equal(t, []string{"frontend/frontend"}, ran)
+
+	var buf bytes.Buffer
+	printMultiAppActionResults(&buf, results)
+	assert.Equal(t, `APP       GROUP        KIND     NAMESPACE  NAME      ACTION  RESULT
+backend   argoproj.io  Rollout  prod       backend   pause   succeeded
+frontend  argoproj.io  Rollout  default    frontend  pause   failed: boom
+`, buf.String())
+}
+
+func TestConfirmMultiAppRun(t *testing.T) {
+	var prompted string
+	prompt := func(answer string) func(message, value string) string {
+		return func(message, value string) string {
+			prompted = message
+			return answer
+		}
+	}
+	assert.True(t, confirmMultiAppRun("project(s) foo", "foo", []string{"a", "b"}, "Rollout/pause", prompt("foo")))
+	assert.Equal(t, "WARNING: this runs 'Rollout/pause' on the matching resources of 2 applications in project(s) foo: a, b\nType 'foo' to proceed", prompted)
+	assert.False(t, confirmMultiAppRun("project(s) foo", "foo", []string{"a", "b"}, "Rollout/pause", prompt("y")))
+	assert.True(t, confirmMultiAppRun("project(s) foo, bar", "foo,bar", []string{"a"}, "Rollout/pause", prompt("foo,bar")))
+}
+
+func TestApplicationTree(t *testing.T) {
+	newApp := func(name string) *argoappv1.ResourceDiff {
+		return newResourceDiff("argoproj.io", "v1alpha1", "Application", "argocd", name)
+	}
+	client := &fakeAppServiceClient{managed: map[string][]*argoappv1.ResourceDiff{
+		"root":     {newApp("frontend"), newApp("backend"), newResourceDiff("", "v1", "ConfigMap", "argocd", "config")},
+		"frontend": {newApp("frontend-cache"), newResourceDiff("argoproj.io", "v1alpha1", "Rollout", "default", "frontend")},
+		// cycle back to root
+		"backend":        {newApp("root")},
+		"frontend-cache": {},
+	}}
+	names, err := applicationTree(context.Background(), client, "root")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"root", "frontend", "backend", "frontend-cache"}, names)
+}
+
+func TestSelectorApplications(t *testing.T) {
+	newApp := func(name string, appLabels map[string]string) argoappv1.Application {
+		return argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: appLabels}}
+	}
+	client := &fakeAppServiceClient{apps: []argoappv1.Application{
+		newApp("payments-web", map[string]string{"team": "payments"}),
+		newApp("checkout", map[string]string{"team": "payments", "tier": "api"}),
+		newApp("search", map[string]string{"team": "discovery"}),
+		newApp("unlabeled", nil),
+	}}
+	names, err := selectorApplications(context.Background(), client, "team=payments")
+	assert.NoError(t, err)
+	// the applications are matched again in case the server ignored the selector
+	assert.Equal(t, []string{"checkout", "payments-web"}, names)
+	if assert.Len(t, client.listQueries, 1) {
+		assert.Equal(t, "team=payments", client.listQueries[0].Selector)
+	}
+
+	names, err = selectorApplications(context.Background(), client, "team in (payments,discovery),!tier")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"payments-web", "search"}, names)
+
+	_, err = selectorApplications(context.Background(), client, "team in (payments")
+	assert.Error(t, err)
+}
+
+func TestConfirmEachResource(t *testing.T) {
+	objs := []*unstructured.Unstructured{newDeployment("default", "a"), newDeployment("default", "b"), newDeployment("default", "c")}
+	var messages []string
+	confirmed, declined := confirmEachResource(objs, "restart", func(message string) bool {
+		messages = append(messages, message)
+		return len(messages) != 2
+	})
+	assert.Equal(t, []string{
+		"[1/3] Run 'restart' on Deployment default/a (y/n)? ",
+		"[2/3] Run 'restart' on Deployment default/b (y/n)? ",
+		"[3/3] Run 'restart' on Deployment default/c (y/n)? ",
+	}, messages)
+	assert.Equal(t, []*unstructured.Unstructured{objs[0], objs[2]}, confirmed)
+	assert.Equal(t, []*unstructured.Unstructured{objs[1]}, declined)
+
+	skipped := declinedActionResults(declined, "restart")
+	if assert.Len(t, skipped, 1) {
+		assert.Equal(t, "b", skipped[0].Name)
+		assert.EqualError(t, skipped[0].Error, "running the action was declined")
+	}
+}
+
+func TestResolveActionName(t *testing.T) {
+	resources := []*argoappv1.ResourceDiff{
+		newResourceDiff("argoproj.io", "v1alpha1", "Rollout", "default", "rollout"),
+		newResourceDiff("apps", "v1", "Deployment", "default", "deploy"),
+		newResourceDiff("example.com", "v1", "Deployment", "default", "custom-deploy"),
+	}
+
+	group, kind, action, err := resolveActionName(resources, "Rollout/pause")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"argoproj.io", "Rollout", "pause"}, []string{group, kind, action})
+
+	group, kind, action, err = resolveActionName(resources, "apps/Deployment/restart")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"apps", "Deployment", "restart"}, []string{group, kind, action})
+
+	_, _, _, err = resolveActionName(resources, "deployments/restart")
+	assert.EqualError(t, err, "Kind 'Deployment' is defined by multiple groups: apps, example.com. Please specify the action as GROUP/KIND/ACTION")
+
+	// no resources match, as opposed to ambiguous arguments
+	_, _, _, err = resolveActionName(resources, "CronJob/restart")
+	assert.IsType(t, noMatchError{}, err)
+}
+
+func TestChooseResourceAction(t *testing.T) {
+	var options []string
+	choose := func(message string, opts []string) int {
+		options = opts
+		return 0
+	}
+	action, err := chooseResourceAction(context.Background(), &fakeAppServiceClient{}, "guestbook", newDeployment("default", "guestbook"), choose)
+	assert.NoError(t, err)
+	assert.Equal(t, "apps/Deployment/restart", action)
+	// unavailable actions are not offered
+	assert.Equal(t, []string{"apps/Deployment/restart"}, options)
+}
+
+func TestRunResourceActionsInChunks(t *testing.T) {
+	var objs []*unstructured.Unstructured
+	for i := 0; i < 5; i++ {
+		objs = append(objs, newDeployment("default", fmt.Sprintf("deploy-%02d", i)))
+	}
+	appName := "guestbook"
+	client := &fakeAppServiceClient{runErr: func(req *applicationpkg.ResourceActionRunRequest) error {
+		if req.ResourceName == "deploy-03" {
+			return fmt.Errorf("action not available")
+		}
+		return nil
+	}}
+	results := runResourceActions(context.Background(), client, applicationpkg.ResourceActionRunRequest{Name: &appName, Action: "restart"}, objs, 1, 2, newActionRateLimiter(0), false, 1)
+
+	// the failure in the second chunk stops the third chunk from being run
+	assert.Equal(t, []int{2, 2}, client.batchSizes)
+	if assert.Len(t, results, 4) {
+		assert.NoError(t, results[2].Error)
+		assert.Equal(t, "deploy-03", results[3].Name)
+		assert.EqualError(t, results[3].Error, "action not available")
+	}
+}
+
+func TestRecordedCommandLine(t *testing.T) {
+	assert.Equal(t, "argocd app actions run guestbook restart --kind Deployment -l \"tier in (web,api)\"",
+		recordedCommandLine([]string{"/usr/local/bin/argocd", "app", "actions", "run", "guestbook", "restart", "--kind", "Deployment", "-l", "tier in (web,api)"}))
+	assert.Equal(t, "argocd app actions run guestbook restart --auth-token REDACTED --auth-token=REDACTED --record",
+		recordedCommandLine([]string{"argocd", "app", "actions", "run", "guestbook", "restart", "--auth-token", "secret", "--auth-token=secret", "--record"}))
+}
@@ -0,0 +1,714 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/localconfig"
+)
+
+// builtinGroupAliases are short names which may be used in place of commonly used API groups
+var builtinGroupAliases = map[string]string{
+	"apiextensions": "apiextensions.k8s.io",
+	"argo":          "argoproj.io",
+	"core":          "",
+	"networking":    "networking.k8s.io",
+	"rbac":          "rbac.authorization.k8s.io",
+	"rollouts":      "argoproj.io",
+	"storage":       "storage.k8s.io",
+	"workflows":     "argoproj.io",
+}
+
+// groupAliases returns the built-in group aliases merged with the aliases configured in the local config
+func groupAliases(clientOpts *argocdclient.ClientOptions) map[string]string {
+	aliases := make(map[string]string)
+	for alias, group := range builtinGroupAliases {
+		aliases[alias] = group
+	}
+	localCfg, err := localconfig.ReadLocalConfig(clientOpts.ConfigPath)
+	checkStatusError(err)
+	if localCfg != nil {
+		for alias, group := range localCfg.GroupAliases {
+			aliases[alias] = group
+		}
+	}
+	return aliases
+}
+
+// parseGVK parses a group/version/Kind reference to a type, in which the group is omitted for the core group, e.g.
+// apps/v1/Deployment or v1/ConfigMap
+func parseGVK(gvk string) (schema.GroupVersionKind, error) {
+	parts := strings.Split(gvk, "/")
+	if len(parts) == 2 {
+		parts = append([]string{""}, parts...)
+	}
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return schema.GroupVersionKind{}, fmt.Errorf("Invalid --gvk '%s'. Expected group/version/Kind, or version/Kind for the core group", gvk)
+	}
+	return schema.GroupVersionKind{Group: parts[0], Version: parts[1], Kind: parts[2]}, nil
+}
+
+// resolveGroupAlias returns the API group for the given alias, or the group unchanged if it is not an alias
+func resolveGroupAlias(group string, aliases map[string]string) string {
+	if resolved, ok := aliases[group]; ok {
+		return resolved
+	}
+	return group
+}
+
+// applySelectorProfile sets the selector flags of the command which were not explicitly specified to the values
+// of the named selector profile from the local config
+func applySelectorProfile(command *cobra.Command, clientOpts *argocdclient.ClientOptions, name string) {
+	localCfg, err := localconfig.ReadLocalConfig(clientOpts.ConfigPath)
+	checkStatusError(err)
+	if localCfg == nil {
+		fatalWithCode(exitCodeInvalidArgs, "Selector profile '%s' undefined: no local config found", name)
+	}
+	profile, err := localCfg.GetSelectorProfile(name)
+	checkStatusError(err)
+	selectors := map[string]string{
+		"group":         profile.Group,
+		"kind":          profile.Kind,
+		"namespace":     profile.Namespace,
+		"resource-name": profile.ResourceName,
+	}
+	for flagName, value := range selectors {
+		flag := command.Flags().Lookup(flagName)
+		if value == "" || flag == nil || flag.Changed {
+			continue
+		}
+		checkStatusError(command.Flags().Set(flagName, value))
+	}
+}
+
+// parseSelector parses a label selector, supporting both equality-based and set-based requirements
+func parseSelector(selector string) labels.Selector {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		fatalWithCode(exitCodeInvalidArgs, "Invalid selector '%s': %v", selector, err)
+	}
+	return parsed
+}
+
+// resourceLabels returns the labels of the managed resource referenced by ref, in the form KIND/NAME or
+// GROUP/KIND/NAME. The labels are taken from the live resource, or from its desired state if it does not exist in
+// the cluster. Resources of the same kind and name in different namespaces are told apart by namespace, if set.
+func resourceLabels(resources []*argoappv1.ResourceDiff, ref string, namespace string) (labels.Set, error) {
+	parts := strings.Split(ref, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[len(parts)-1] == "" {
+		return nil, fmt.Errorf("Invalid resource '%s'. Expected KIND/NAME or GROUP/KIND/NAME", ref)
+	}
+	group, hasGroup := "", len(parts) == 3
+	if hasGroup {
+		group = parts[0]
+	}
+	kind, name := normalizeKind(parts[len(parts)-2]), parts[len(parts)-1]
+	var matches []*argoappv1.ResourceDiff
+	for _, res := range resources {
+		if res.Kind != kind || res.Name != name || hasGroup && res.Group != group || namespace != "" && res.Namespace != namespace {
+			continue
+		}
+		matches = append(matches, res)
+	}
+	switch len(matches) {
+	case 0:
+		return nil, noMatchError{fmt.Errorf("Resource '%s' is not managed by the application", ref)}
+	case 1:
+	default:
+		var namespaces []string
+		for _, res := range matches {
+			namespaces = append(namespaces, res.Namespace)
+		}
+		return nil, fmt.Errorf("Resource '%s' is ambiguous, it exists in the namespaces %s. Please specify --namespace", ref, strings.Join(namespaces, ", "))
+	}
+	obj, err := matches[0].LiveObject()
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		if obj, err = matches[0].TargetObject(); err != nil {
+			return nil, err
+		}
+	}
+	if obj == nil || len(obj.GetLabels()) == 0 {
+		return nil, fmt.Errorf("Resource '%s' has no labels to select resources by", ref)
+	}
+	return labels.Set(obj.GetLabels()), nil
+}
+
+// withResourceLabels returns the selector additionally requiring the labels of the managed resource referenced by
+// ref. Exits if the resource cannot be found.
+func withResourceLabels(selector labels.Selector, resources []*argoappv1.ResourceDiff, ref string, namespace string) labels.Selector {
+	set, err := resourceLabels(resources, ref, namespace)
+	if _, ok := err.(noMatchError); ok {
+		fatalWithCode(exitCodeNoMatch, "%v", err)
+	} else if err != nil {
+		fatalWithCode(exitCodeInvalidArgs, "%v", err)
+	}
+	requirements, _ := labels.SelectorFromSet(set).Requirements()
+	return selector.Add(requirements...)
+}
+
+// filterByNamespaceSelector returns the namespaced resources whose namespace labels match the selector. The server
+// does not expose namespace labels, so they are taken from the live Namespace resources managed by the application.
+func filterByNamespaceSelector(resources []*argoappv1.ResourceDiff, selector labels.Selector) ([]*argoappv1.ResourceDiff, error) {
+	namespaceLabels := make(map[string]labels.Set)
+	for _, res := range resources {
+		if res.Group != "" || res.Kind != "Namespace" {
+			continue
+		}
+		obj, err := res.LiveObject()
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			namespaceLabels[res.Name] = labels.Set(obj.GetLabels())
+		}
+	}
+	var filtered []*argoappv1.ResourceDiff
+	unknown := make(map[string]bool)
+	for _, res := range resources {
+		if res.Namespace == "" {
+			continue
+		}
+		nsLabels, ok := namespaceLabels[res.Namespace]
+		if !ok {
+			unknown[res.Namespace] = true
+			continue
+		}
+		if selector.Matches(nsLabels) {
+			filtered = append(filtered, res)
+		}
+	}
+	if len(unknown) > 0 {
+		var names []string
+		for name := range unknown {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("Labels of namespaces %s are unknown since they are not managed by the application. Use --namespace to select resources in namespaces which are not managed by the application", strings.Join(names, ", "))
+	}
+	return filtered, nil
+}
+
+// parseAge parses a duration as accepted by time.ParseDuration, additionally allowing a leading number of days,
+// e.g. 7d or 1d12h
+func parseAge(age string) (time.Duration, error) {
+	var days time.Duration
+	rest := age
+	if i := strings.Index(rest, "d"); i >= 0 {
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("Invalid duration '%s'", age)
+		}
+		days = time.Duration(n) * 24 * time.Hour
+		rest = rest[i+1:]
+		if rest == "" {
+			return days, nil
+		}
+	}
+	d, err := time.ParseDuration(rest)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("Invalid duration '%s'", age)
+	}
+	return days + d, nil
+}
+
+// filterByAge returns the resources whose live object was created at least olderThan and at most maxAge before now.
+// A zero duration disables the respective bound. Resources which do not exist in the cluster have no creation time
+// and are never selected.
+func filterByAge(resources []*argoappv1.ResourceDiff, now time.Time, olderThan time.Duration, maxAge time.Duration) ([]*argoappv1.ResourceDiff, error) {
+	var filtered []*argoappv1.ResourceDiff
+	for _, res := range resources {
+		obj, err := res.LiveObject()
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		age := now.Sub(obj.GetCreationTimestamp().Time)
+		if olderThan > 0 && age < olderThan {
+			continue
+		}
+		if maxAge > 0 && age > maxAge {
+			continue
+		}
+		filtered = append(filtered, res)
+	}
+	return filtered, nil
+}
+
+// imageKinds are the kinds of workloads whose pod template is inspected by --image
+var imageKinds = []string{"Deployment", "StatefulSet", "DaemonSet", "Rollout"}
+
+// containerImages returns the images of the containers and init containers in the pod template of the workload. Only
+// workloads of imageKinds have a pod template.
+func containerImages(obj *unstructured.Unstructured) []string {
+	if !containsString(imageKinds, obj.GetKind()) {
+		return nil
+	}
+	var images []string
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", field)
+		for _, container := range containers {
+			if container, ok := container.(map[string]interface{}); ok {
+				if image, ok := container["image"].(string); ok && image != "" {
+					images = append(images, image)
+				}
+			}
+		}
+	}
+	return images
+}
+
+// parseImagePattern validates the value of the --image flag
+func parseImagePattern(image string) *regexp.Regexp {
+	pattern, err := regexp.Compile(image)
+	if err != nil {
+		fatalWithCode(exitCodeInvalidArgs, "Invalid --image pattern '%s': %v", image, err)
+	}
+	return pattern
+}
+
+// filterByImage returns the workloads with a container, or init container, whose image matches the pattern. Resources
+// which are not workloads of imageKinds or do not exist in the cluster are never selected.
+func filterByImage(resources []*argoappv1.ResourceDiff, pattern *regexp.Regexp) ([]*argoappv1.ResourceDiff, error) {
+	var filtered []*argoappv1.ResourceDiff
+	for _, res := range resources {
+		obj, err := res.LiveObject()
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		for _, image := range containerImages(obj) {
+			if pattern.MatchString(image) {
+				filtered = append(filtered, res)
+				break
+			}
+		}
+	}
+	return filtered, nil
+}
+
+// changedSinceLastRun is the value of --only-if-changed selecting the resources which changed since the time recorded
+// in their run annotation
+const changedSinceLastRun = "last-run"
+
+// parseChangedSince parses the value of --only-if-changed, which is either a RFC3339 timestamp, a duration before now,
+// e.g. 7d or 36h, or changedSinceLastRun, in which case the zero time is returned
+func parseChangedSince(since string, now time.Time) (time.Time, error) {
+	if since == changedSinceLastRun {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+	age, err := parseAge(since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid --only-if-changed '%s'. Expected a RFC3339 timestamp, a duration such as 7d or 36h, or %s", since, changedSinceLastRun)
+	}
+	return now.Add(-age), nil
+}
+
+// lastChangeTime returns the latest time at which the fields of the object were updated, as recorded in its managed
+// fields. Updates made by the given field manager, i.e. by actions, are not considered changes. False is returned if
+// the object records no update times.
+func lastChangeTime(obj *unstructured.Unstructured, fieldManager string) (time.Time, bool) {
+	var last time.Time
+	found := false
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Time == nil || entry.Manager == fieldManager {
+			continue
+		}
+		found = true
+		if entry.Time.After(last) {
+			last = entry.Time.Time
+		}
+	}
+	return last, found
+}
+
+// lastRunTime returns the time recorded in the run annotation of the object, if any
+func lastRunTime(obj *unstructured.Unstructured, annotationKey string) (time.Time, bool) {
+	value, ok := obj.GetAnnotations()[annotationKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	var annotation struct {
+		Timestamp string `json:"timestamp"`
+	}
+	if err := json.Unmarshal([]byte(value), &annotation); err != nil {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, annotation.Timestamp)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// filterUnchanged splits the objects into those which changed after since, or after the time recorded in their run
+// annotation if since is zero, and those which did not. Objects whose change time is unknown, or which were never
+// annotated, are considered changed so that the action is never wrongly skipped.
+func filterUnchanged(objs []*unstructured.Unstructured, since time.Time, annotationKey string, fieldManager string) ([]*unstructured.Unstructured, []*unstructured.Unstructured) {
+	var changed, unchanged []*unstructured.Unstructured
+	for _, obj := range objs {
+		threshold := since
+		if threshold.IsZero() {
+			runTime, ok := lastRunTime(obj, annotationKey)
+			if !ok {
+				changed = append(changed, obj)
+				continue
+			}
+			threshold = runTime
+		}
+		changeTime, ok := lastChangeTime(obj, fieldManager)
+		if !ok || changeTime.After(threshold) {
+			changed = append(changed, obj)
+		} else {
+			unchanged = append(unchanged, obj)
+		}
+	}
+	return changed, unchanged
+}
+
+// selectorFile is the schema of the file loaded with --selector-file, which describes a set of selectors that can be
+// kept under version control, e.g.:
+//
+//	kinds: [Deployment, StatefulSet]
+//	namespaces: [staging, prod]
+//	labels: tier!=cache
+//	exclude:
+//	- kind: Deployment
+//	  namespace: prod
+//	  name: payments
+type selectorFile struct {
+	// Kinds are the kinds of the selected resources, singular or plural
+	Kinds []string `json:"kinds,omitempty"`
+	// Namespaces are the namespaces of the selected resources
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Labels is a label selector in the syntax of --selector
+	Labels string `json:"labels,omitempty"`
+	// Exclude are resources which are never selected, even if they match the other selectors
+	Exclude []selectorFileExclude `json:"exclude,omitempty"`
+}
+
+// selectorFileExclude matches the resources excluded by a selector file. Empty fields match any resource.
+type selectorFileExclude struct {
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// matches returns whether the exclude matches the resource
+func (e selectorFileExclude) matches(res *argoappv1.ResourceDiff) bool {
+	return (e.Kind == "" || normalizeKind(e.Kind) == res.Kind) &&
+		(e.Namespace == "" || e.Namespace == res.Namespace) &&
+		(e.Name == "" || e.Name == res.Name)
+}
+
+// readSelectorFile reads and validates a selector file. Unknown fields are rejected so that typos do not silently
+// widen the selection.
+func readSelectorFile(path string) (*selectorFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	var file selectorFile
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	for i, kind := range file.Kinds {
+		if kind == "" {
+			return nil, fmt.Errorf("%s: kinds[%d] must not be empty", path, i)
+		}
+	}
+	for i, namespace := range file.Namespaces {
+		if namespace == "" {
+			return nil, fmt.Errorf("%s: namespaces[%d] must not be empty", path, i)
+		}
+	}
+	if _, err := labels.Parse(file.Labels); err != nil {
+		return nil, fmt.Errorf("%s: invalid labels '%s': %v", path, file.Labels, err)
+	}
+	for i, exclude := range file.Exclude {
+		if exclude == (selectorFileExclude{}) {
+			return nil, fmt.Errorf("%s: exclude[%d] must specify at least one of kind, namespace or name", path, i)
+		}
+	}
+	return &file, nil
+}
+
+// kubectlObject identifies an object listed in the output of 'kubectl get -o json'
+type kubectlObject struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (o kubectlObject) String() string {
+	return fmt.Sprintf("%s/%s/%s", o.Kind, o.Namespace, o.Name)
+}
+
+// readKubectlList reads the objects of a Kubernetes List, as printed by 'kubectl get -o json', from the file at path,
+// or from stdin if path is "-"
+func readKubectlList(path string, stdin io.Reader) ([]kubectlObject, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = ioutil.ReadAll(stdin)
+	} else {
+		data, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseKubectlList(data)
+}
+
+// parseKubectlList parses the objects of a Kubernetes List. An error is returned unless the data is a List, which
+// e.g. the output of 'kubectl get -o json' for a single object is not, or if any of its items lacks a kind or name.
+func parseKubectlList(data []byte) ([]kubectlObject, error) {
+	var list struct {
+		APIVersion string                   `json:"apiVersion"`
+		Kind       string                   `json:"kind"`
+		Items      []map[string]interface{} `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("Input is not the json output of 'kubectl get -o json': %v", err)
+	}
+	if list.APIVersion == "" || !strings.HasSuffix(list.Kind, "List") || list.Items == nil {
+		return nil, fmt.Errorf("Input is not a Kubernetes List. Pass the output of 'kubectl get -o json' for a kind or label query rather than for a single object")
+	}
+	// an empty list selects no resources rather than leaving the resources unfiltered
+	objs := []kubectlObject{}
+	for i, item := range list.Items {
+		obj := unstructured.Unstructured{Object: item}
+		gv, err := schema.ParseGroupVersion(obj.GetAPIVersion())
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %v", i, err)
+		}
+		if obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("items[%d] must have a kind and a name", i)
+		}
+		objs = append(objs, kubectlObject{Group: gv.Group, Kind: obj.GetKind(), Namespace: obj.GetNamespace(), Name: obj.GetName()})
+	}
+	return objs, nil
+}
+
+// filterByKubectlList returns the resources which are among the listed objects, along with the listed objects which
+// are not among the resources
+func filterByKubectlList(resources []*argoappv1.ResourceDiff, objs []kubectlObject) ([]*argoappv1.ResourceDiff, []kubectlObject) {
+	listed := make(map[kubectlObject]bool)
+	for _, obj := range objs {
+		listed[obj] = true
+	}
+	managed := make(map[kubectlObject]bool)
+	var filtered []*argoappv1.ResourceDiff
+	for _, res := range resources {
+		key := kubectlObject{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
+		if listed[key] {
+			managed[key] = true
+			filtered = append(filtered, res)
+		}
+	}
+	var unmanaged []kubectlObject
+	for _, obj := range objs {
+		if !managed[obj] {
+			unmanaged = append(unmanaged, obj)
+		}
+	}
+	return filtered, unmanaged
+}
+
+// applySelectorFile sets the label selector of the command to the labels of the selector file unless the selector
+// was explicitly specified, and drops the kinds and namespaces of the file which are overridden by the --kind and
+// --namespace flags
+func applySelectorFile(command *cobra.Command, file *selectorFile) {
+	if flag := command.Flags().Lookup("selector"); file.Labels != "" && flag != nil && !flag.Changed {
+		checkStatusError(command.Flags().Set("selector", file.Labels))
+	}
+	if command.Flags().Changed("kind") {
+		file.Kinds = nil
+	}
+	if command.Flags().Changed("namespace") {
+		file.Namespaces = nil
+	}
+}
+
+// filterBySelectorFile returns the resources which match the kinds and namespaces of the selector file and are not
+// excluded by it. Cluster-scoped resources match any namespace.
+func filterBySelectorFile(resources []*argoappv1.ResourceDiff, file *selectorFile) []*argoappv1.ResourceDiff {
+	kinds := make(map[string]bool)
+	for _, kind := range file.Kinds {
+		kinds[normalizeKind(kind)] = true
+	}
+	namespaces := make(map[string]bool)
+	for _, namespace := range file.Namespaces {
+		namespaces[namespace] = true
+	}
+	var filtered []*argoappv1.ResourceDiff
+	for _, res := range resources {
+		if len(kinds) > 0 && !kinds[res.Kind] {
+			continue
+		}
+		if len(namespaces) > 0 && !namespaces[res.Namespace] && resourceScope(schema.GroupKind{Group: res.Group, Kind: res.Kind}, res.Namespace) == scopeNamespaced {
+			continue
+		}
+		excluded := false
+		for _, exclude := range file.Exclude {
+			if exclude.matches(res) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			filtered = append(filtered, res)
+		}
+	}
+	return filtered
+}
+
+// parseAgeFlags parses the --older-than and --max-age flags
+func parseAgeFlags(olderThan string, maxAge string) (time.Duration, time.Duration) {
+	var minDuration, maxDuration time.Duration
+	var err error
+	if olderThan != "" {
+		if minDuration, err = parseAge(olderThan); err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "Invalid --older-than: %v", err)
+		}
+	}
+	if maxAge != "" {
+		if maxDuration, err = parseAge(maxAge); err != nil {
+			fatalWithCode(exitCodeInvalidArgs, "Invalid --max-age: %v", err)
+		}
+	}
+	if minDuration > 0 && maxDuration > 0 && minDuration > maxDuration {
+		fatalWithCode(exitCodeInvalidArgs, "--older-than must not be greater than --max-age")
+	}
+	return minDuration, maxDuration
+}
+
+// parseSyncStatus validates the value of the --sync-status flag
+func parseSyncStatus(syncStatus string) argoappv1.SyncStatusCode {
+	for _, status := range []argoappv1.SyncStatusCode{argoappv1.SyncStatusCodeSynced, argoappv1.SyncStatusCodeOutOfSync, argoappv1.SyncStatusCodeUnknown} {
+		if syncStatus == string(status) {
+			return status
+		}
+	}
+	fatalWithCode(exitCodeInvalidArgs, "Unsupported --sync-status value '%s'. One of: %s, %s, %s", syncStatus, argoappv1.SyncStatusCodeSynced, argoappv1.SyncStatusCodeOutOfSync, argoappv1.SyncStatusCodeUnknown)
+	return ""
+}
+
+// filterBySyncStatus returns the resources whose sync status, as reported in the application status, matches the
+// given status. Resources missing from the application status are considered to have an unknown sync status.
+func filterBySyncStatus(resources []*argoappv1.ResourceDiff, statuses []argoappv1.ResourceStatus, syncStatus argoappv1.SyncStatusCode) []*argoappv1.ResourceDiff {
+	resourceStatuses := make(map[string]argoappv1.SyncStatusCode)
+	for _, res := range statuses {
+		resourceStatuses[res.Group+"\t"+res.Kind+"\t"+res.Namespace+"\t"+res.Name] = res.Status
+	}
+	var filtered []*argoappv1.ResourceDiff
+	for _, res := range resources {
+		status, ok := resourceStatuses[res.Group+"\t"+res.Kind+"\t"+res.Namespace+"\t"+res.Name]
+		if !ok || status == "" {
+			status = argoappv1.SyncStatusCodeUnknown
+		}
+		if status == syncStatus {
+			filtered = append(filtered, res)
+		}
+	}
+	return filtered
+}
+
+// healthStatusCodes are the values accepted by the --health flag
+var healthStatusCodes = []argoappv1.HealthStatusCode{
+	argoappv1.HealthStatusHealthy,
+	argoappv1.HealthStatusProgressing,
+	argoappv1.HealthStatusDegraded,
+	argoappv1.HealthStatusSuspended,
+	argoappv1.HealthStatusMissing,
+	argoappv1.HealthStatusUnknown,
+}
+
+// parseHealthStatus validates the value of the --health flag
+func parseHealthStatus(health string) argoappv1.HealthStatusCode {
+	var codes []string
+	for _, code := range healthStatusCodes {
+		if health == string(code) {
+			return code
+		}
+		codes = append(codes, string(code))
+	}
+	fatalWithCode(exitCodeInvalidArgs, "Unsupported --health value '%s'. One of: %s", health, strings.Join(codes, ", "))
+	return ""
+}
+
+// filterByHealthStatus returns the resources whose health, as reported in the application status, matches the given
+// health. Resources missing from the application status or without a health assessment are considered to have an
+// unknown health.
+func filterByHealthStatus(resources []*argoappv1.ResourceDiff, statuses []argoappv1.ResourceStatus, health argoappv1.HealthStatusCode) []*argoappv1.ResourceDiff {
+	resourceHealth := make(map[string]argoappv1.HealthStatusCode)
+	for _, res := range statuses {
+		if res.Health != nil {
+			resourceHealth[res.Group+"\t"+res.Kind+"\t"+res.Namespace+"\t"+res.Name] = res.Health.Status
+		}
+	}
+	var filtered []*argoappv1.ResourceDiff
+	for _, res := range resources {
+		status, ok := resourceHealth[res.Group+"\t"+res.Kind+"\t"+res.Namespace+"\t"+res.Name]
+		if !ok || status == "" {
+			status = argoappv1.HealthStatusUnknown
+		}
+		if status == health {
+			filtered = append(filtered, res)
+		}
+	}
+	return filtered
+}
+
+// printGroupAliases prints the given group aliases as a table
+func printGroupAliases(aliases map[string]string) {
+	var names []string
+	for alias := range aliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ALIAS\tGROUP\n")
+	for _, alias := range names {
+		group := aliases[alias]
+		if group == "" {
+			group = "(core)"
+		}
+		fmt.Fprintf(w, "%s\t%s\n", alias, group)
+	}
+	_ = w.Flush()
+}
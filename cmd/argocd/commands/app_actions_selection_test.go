@@ -0,0 +1,460 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/common"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/localconfig"
+)
+
+func TestParseGVK(t *testing.T) {
+	gvk, err := parseGVK("apps/v1/Deployment")
+	assert.NoError(t, err)
+	assert.Equal(t, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, gvk)
+
+	gvk, err = parseGVK("v1/ConfigMap")
+	assert.NoError(t, err)
+	assert.Equal(t, schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, gvk)
+
+	for _, invalid := range []string{"", "Deployment", "apps/v1/", "apps//Deployment", "a/b/c/d"} {
+		_, err := parseGVK(invalid)
+		assert.EqualError(t, err, fmt.Sprintf("Invalid --gvk '%s'. Expected group/version/Kind, or version/Kind for the core group", invalid))
+	}
+}
+
+func TestReadSelectorFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "actions-selector")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	writeSelectorFile := func(content string) string {
+		path := filepath.Join(dir, "selector.yaml")
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	file, err := readSelectorFile(writeSelectorFile(`
+kinds: [deployments, StatefulSet]
+namespaces: [prod]
+labels: tier!=cache
+exclude:
+- kind: Deployment
+  name: payments
+`))
+	if assert.NoError(t, err) {
+		assert.Equal(t, &selectorFile{
+			Kinds:      []string{"deployments", "StatefulSet"},
+			Namespaces: []string{"prod"},
+			Labels:     "tier!=cache",
+			Exclude:    []selectorFileExclude{{Kind: "Deployment", Name: "payments"}},
+		}, file)
+	}
+
+	_, err = readSelectorFile(writeSelectorFile("kind: Deployment"))
+	assert.Contains(t, fmt.Sprintf("%v", err), `unknown field "kind"`)
+	_, err = readSelectorFile(writeSelectorFile("labels: 'env in (prod'"))
+	assert.Contains(t, fmt.Sprintf("%v", err), "invalid labels")
+	_, err = readSelectorFile(writeSelectorFile("exclude: [{}]"))
+	assert.Contains(t, fmt.Sprintf("%v", err), "exclude[0] must specify at least one of kind, namespace or name")
+	_, err = readSelectorFile(writeSelectorFile("kinds: ['']"))
+	assert.Contains(t, fmt.Sprintf("%v", err), "kinds[0] must not be empty")
+}
+
+func TestApplySelectorFile(t *testing.T) {
+	var kind, namespace, selector string
+	newCommand := func() *cobra.Command {
+		command := &cobra.Command{}
+		command.Flags().StringVar(&kind, "kind", "", "")
+		command.Flags().StringVar(&namespace, "namespace", "", "")
+		command.Flags().StringVar(&selector, "selector", "", "")
+		return command
+	}
+
+	command := newCommand()
+	file := &selectorFile{Kinds: []string{"Deployment"}, Namespaces: []string{"prod"}, Labels: "tier=web"}
+	applySelectorFile(command, file)
+	assert.Equal(t, "tier=web", selector)
+	assert.Equal(t, []string{"Deployment"}, file.Kinds)
+	assert.Equal(t, []string{"prod"}, file.Namespaces)
+
+	// explicitly specified flags take precedence over the file
+	command = newCommand()
+	assert.NoError(t, command.Flags().Parse([]string{"--kind", "StatefulSet", "--namespace", "staging", "--selector", "tier=db"}))
+	file = &selectorFile{Kinds: []string{"Deployment"}, Namespaces: []string{"prod"}, Labels: "tier=web"}
+	applySelectorFile(command, file)
+	assert.Equal(t, "tier=db", selector)
+	assert.Empty(t, file.Kinds)
+	assert.Empty(t, file.Namespaces)
+}
+
+func TestFilterBySelectorFile(t *testing.T) {
+	resources := []*argoappv1.ResourceDiff{
+		newResourceDiff("apps", "v1", "Deployment", "prod", "guestbook"),
+		newResourceDiff("apps", "v1", "Deployment", "prod", "payments"),
+		newResourceDiff("apps", "v1", "Deployment", "staging", "guestbook"),
+		newResourceDiff("apps", "v1", "StatefulSet", "prod", "db"),
+		newResourceDiff("", "v1", "ConfigMap", "prod", "config"),
+	}
+	names := func(resources []*argoappv1.ResourceDiff) []string {
+		var names []string
+		for _, res := range resources {
+			names = append(names, fmt.Sprintf("%s/%s/%s", res.Kind, res.Namespace, res.Name))
+		}
+		return names
+	}
+
+	filtered := filterBySelectorFile(resources, &selectorFile{
+		Kinds:      []string{"deployments", "StatefulSet"},
+		Namespaces: []string{"prod"},
+		Exclude:    []selectorFileExclude{{Kind: "Deployment", Name: "payments"}},
+	})
+	assert.Equal(t, []string{"Deployment/prod/guestbook", "StatefulSet/prod/db"}, names(filtered))
+
+	filtered = filterBySelectorFile(resources, &selectorFile{Exclude: []selectorFileExclude{{Namespace: "prod"}}})
+	assert.Equal(t, []string{"Deployment/staging/guestbook"}, names(filtered))
+}
+
+func TestParseChangedSince(t *testing.T) {
+	now := time.Date(2020, 1, 10, 12, 0, 0, 0, time.UTC)
+	since, err := parseChangedSince("2020-01-01T00:00:00Z", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), since)
+	since, err = parseChangedSince("1d12h", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 9, 0, 0, 0, 0, time.UTC), since)
+	since, err = parseChangedSince(changedSinceLastRun, now)
+	assert.NoError(t, err)
+	assert.True(t, since.IsZero())
+	_, err = parseChangedSince("yesterday", now)
+	assert.Error(t, err)
+}
+
+func TestFilterUnchanged(t *testing.T) {
+	withChanges := func(obj *unstructured.Unstructured, lastRun string, changes map[string]string) *unstructured.Unstructured {
+		var fields []interface{}
+		for manager, changed := range changes {
+			fields = append(fields, map[string]interface{}{"manager": manager, "operation": "Update", "time": changed})
+		}
+		if fields != nil {
+			assert.NoError(t, unstructured.SetNestedSlice(obj.Object, fields, "metadata", "managedFields"))
+		}
+		if lastRun != "" {
+			obj.SetAnnotations(map[string]string{defaultRunAnnotationKey: fmt.Sprintf(`{"action":"restart","user":"admin","timestamp":"%s"}`, lastRun)})
+		}
+		return obj
+	}
+	objs := []*unstructured.Unstructured{
+		withChanges(newDeployment("default", "changed"), "2020-01-02T00:00:00Z", map[string]string{"kubectl": "2020-01-03T00:00:00Z"}),
+		// changes made by actions are ignored
+		withChanges(newDeployment("default", "unchanged"), "2020-01-02T00:00:00Z", map[string]string{"kubectl": "2020-01-01T00:00:00Z", common.ArgoCDActionsFieldManager: "2020-01-05T00:00:00Z"}),
+		// resources which record no changes or were never annotated are considered changed
+		withChanges(newDeployment("default", "unknown"), "2020-01-02T00:00:00Z", nil),
+		withChanges(newDeployment("default", "never-run"), "", map[string]string{"kubectl": "2020-01-01T00:00:00Z"}),
+	}
+	names := func(objs []*unstructured.Unstructured) []string {
+		var names []string
+		for _, obj := range objs {
+			names = append(names, obj.GetName())
+		}
+		return names
+	}
+
+	changed, unchanged := filterUnchanged(objs, time.Time{}, defaultRunAnnotationKey, common.ArgoCDActionsFieldManager)
+	assert.Equal(t, []string{"changed", "unknown", "never-run"}, names(changed))
+	assert.Equal(t, []string{"unchanged"}, names(unchanged))
+
+	changed, unchanged = filterUnchanged(objs, time.Date(2020, 1, 2, 12, 0, 0, 0, time.UTC), defaultRunAnnotationKey, common.ArgoCDActionsFieldManager)
+	assert.Equal(t, []string{"changed", "unknown"}, names(changed))
+	assert.Equal(t, []string{"unchanged", "never-run"}, names(unchanged))
+}
+
+func TestReadKubectlList(t *testing.T) {
+	list := `{"apiVersion": "v1", "kind": "List", "items": [
+		{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"namespace": "prod", "name": "guestbook"}},
+		{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "prod"}}
+	]}`
+	objs, err := readKubectlList("-", strings.NewReader(list))
+	assert.NoError(t, err)
+	assert.Equal(t, []kubectlObject{{Group: "apps", Kind: "Deployment", Namespace: "prod", Name: "guestbook"}, {Kind: "Namespace", Name: "prod"}}, objs)
+
+	// an empty list selects nothing
+	objs, err = parseKubectlList([]byte(`{"apiVersion": "v1", "kind": "List", "items": []}`))
+	assert.NoError(t, err)
+	assert.NotNil(t, objs)
+	assert.Empty(t, objs)
+
+	// the output for a single object is not a list
+	_, err = parseKubectlList([]byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook"}}`))
+	assert.EqualError(t, err, "Input is not a Kubernetes List. Pass the output of 'kubectl get -o json' for a kind or label query rather than for a single object")
+	_, err = parseKubectlList([]byte(`{"apiVersion": "v1", "kind": "List", "items": [{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {}}]}`))
+	assert.EqualError(t, err, "items[0] must have a kind and a name")
+	_, err = parseKubectlList([]byte(`NAME   READY`))
+	assert.Error(t, err)
+}
+
+func TestFilterByKubectlList(t *testing.T) {
+	resources := []*argoappv1.ResourceDiff{
+		newResourceDiff("apps", "v1", "Deployment", "prod", "guestbook"),
+		newResourceDiff("apps", "v1", "Deployment", "prod", "payments"),
+	}
+	filtered, unmanaged := filterByKubectlList(resources, []kubectlObject{
+		{Group: "apps", Kind: "Deployment", Namespace: "prod", Name: "guestbook"},
+		{Group: "apps", Kind: "Deployment", Namespace: "prod", Name: "other"},
+	})
+	if assert.Len(t, filtered, 1) {
+		assert.Equal(t, "guestbook", filtered[0].Name)
+	}
+	assert.Equal(t, []kubectlObject{{Group: "apps", Kind: "Deployment", Namespace: "prod", Name: "other"}}, unmanaged)
+}
+
+func TestResolveGroupAlias(t *testing.T) {
+	aliases := map[string]string{"rollouts": "argoproj.io", "core": ""}
+	assert.Equal(t, "argoproj.io", resolveGroupAlias("rollouts", aliases))
+	assert.Equal(t, "", resolveGroupAlias("core", aliases))
+	assert.Equal(t, "apps", resolveGroupAlias("apps", aliases))
+}
+
+func TestGroupAliasesFromLocalConfig(t *testing.T) {
+	configPath, cleanup := writeTestLocalConfig(t, func(cfg *localconfig.LocalConfig) {
+		cfg.GroupAliases = map[string]string{"rollouts": "example.com", "mesh": "networking.istio.io"}
+	})
+	defer cleanup()
+
+	aliases := groupAliases(&argocdclient.ClientOptions{ConfigPath: configPath})
+	assert.Equal(t, "example.com", aliases["rollouts"])
+	assert.Equal(t, "networking.istio.io", aliases["mesh"])
+	assert.Equal(t, "argoproj.io", aliases["argo"])
+}
+
+func TestApplySelectorProfile(t *testing.T) {
+	configPath, cleanup := writeTestLocalConfig(t, func(cfg *localconfig.LocalConfig) {
+		cfg.SelectorProfiles = []localconfig.SelectorProfile{
+			{Name: "prod-backends", Group: "apps", Kind: "Deployment", Namespace: "prod"},
+		}
+	})
+	defer cleanup()
+
+	command := NewApplicationResourceActionsListCommand(&argocdclient.ClientOptions{ConfigPath: configPath})
+	assert.NoError(t, command.Flags().Set("namespace", "staging"))
+	applySelectorProfile(command, &argocdclient.ClientOptions{ConfigPath: configPath}, "prod-backends")
+
+	assert.Equal(t, "apps", command.Flag("group").Value.String())
+	assert.True(t, command.Flag("group").Changed)
+	assert.Equal(t, "Deployment", command.Flag("kind").Value.String())
+	assert.Equal(t, "staging", command.Flag("namespace").Value.String())
+	assert.Equal(t, "", command.Flag("resource-name").Value.String())
+}
+
+func TestResourceLabels(t *testing.T) {
+	newDeployment := func(namespace, name, labelsJSON string) *argoappv1.ResourceDiff {
+		return &argoappv1.ResourceDiff{
+			Group:     "apps",
+			Kind:      "Deployment",
+			Namespace: namespace,
+			Name:      name,
+			LiveState: fmt.Sprintf(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "%s", "namespace": "%s", "labels": %s}}`, name, namespace, labelsJSON),
+		}
+	}
+	resources := []*argoappv1.ResourceDiff{
+		newDeployment("default", "web", `{"app": "guestbook", "tier": "web"}`),
+		newDeployment("default", "unlabeled", `{}`),
+		newDeployment("prod", "api", `{"app": "guestbook"}`),
+		newDeployment("staging", "api", `{"app": "guestbook"}`),
+		// not in the cluster, so the labels are taken from git
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "missing", TargetState: `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "missing", "labels": {"tier": "cache"}}}`},
+	}
+
+	set, err := resourceLabels(resources, "deployments/web", "")
+	assert.NoError(t, err)
+	assert.Equal(t, labels.Set{"app": "guestbook", "tier": "web"}, set)
+	set, err = resourceLabels(resources, "apps/Deployment/missing", "")
+	assert.NoError(t, err)
+	assert.Equal(t, labels.Set{"tier": "cache"}, set)
+	set, err = resourceLabels(resources, "Deployment/api", "prod")
+	assert.NoError(t, err)
+	assert.Equal(t, labels.Set{"app": "guestbook"}, set)
+
+	_, err = resourceLabels(resources, "Deployment/api", "")
+	assert.EqualError(t, err, "Resource 'Deployment/api' is ambiguous, it exists in the namespaces prod, staging. Please specify --namespace")
+	_, err = resourceLabels(resources, "Deployment/other", "")
+	assert.IsType(t, noMatchError{}, err)
+	assert.EqualError(t, err, "Resource 'Deployment/other' is not managed by the application")
+	_, err = resourceLabels(resources, "extensions/Deployment/web", "")
+	assert.IsType(t, noMatchError{}, err)
+	_, err = resourceLabels(resources, "Deployment/unlabeled", "")
+	assert.EqualError(t, err, "Resource 'Deployment/unlabeled' has no labels to select resources by")
+	_, err = resourceLabels(resources, "web", "")
+	assert.EqualError(t, err, "Invalid resource 'web'. Expected KIND/NAME or GROUP/KIND/NAME")
+}
+
+func TestFilterByNamespaceSelector(t *testing.T) {
+	newNamespace := func(name, tier string) *argoappv1.ResourceDiff {
+		return &argoappv1.ResourceDiff{
+			Kind:      "Namespace",
+			Name:      name,
+			LiveState: fmt.Sprintf(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "%s", "labels": {"tier": "%s"}}}`, name, tier),
+		}
+	}
+	resources := []*argoappv1.ResourceDiff{
+		newNamespace("gold-ns", "gold"),
+		newNamespace("silver-ns", "silver"),
+		newResourceDiff("apps", "v1", "Deployment", "gold-ns", "gold-deploy"),
+		newResourceDiff("apps", "v1", "Deployment", "silver-ns", "silver-deploy"),
+	}
+	selector, err := labels.Parse("tier=gold")
+	assert.NoError(t, err)
+
+	filtered, err := filterByNamespaceSelector(resources, selector)
+	assert.NoError(t, err)
+	if assert.Len(t, filtered, 1) {
+		assert.Equal(t, "gold-deploy", filtered[0].Name)
+	}
+
+	_, err = filterByNamespaceSelector(append(resources, newResourceDiff("apps", "v1", "Deployment", "other-ns", "other-deploy")), selector)
+	assert.EqualError(t, err, "Labels of namespaces other-ns are unknown since they are not managed by the application. Use --namespace to select resources in namespaces which are not managed by the application")
+}
+
+func TestParseAge(t *testing.T) {
+	for age, expected := range map[string]time.Duration{
+		"7d":    7 * 24 * time.Hour,
+		"1d12h": 36 * time.Hour,
+		"90m":   90 * time.Minute,
+	} {
+		d, err := parseAge(age)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, d, age)
+	}
+	for _, age := range []string{"", "d", "xd", "-1d", "1d-1h", "7 days"} {
+		_, err := parseAge(age)
+		assert.Error(t, err, age)
+	}
+}
+
+func TestFilterByAge(t *testing.T) {
+	now := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	newCreatedAt := func(name string, created time.Time) *argoappv1.ResourceDiff {
+		return &argoappv1.ResourceDiff{
+			Group:     "apps",
+			Kind:      "Deployment",
+			Namespace: "default",
+			Name:      name,
+			LiveState: fmt.Sprintf(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "%s", "namespace": "default", "creationTimestamp": "%s"}}`, name, created.Format(time.RFC3339)),
+		}
+	}
+	resources := []*argoappv1.ResourceDiff{
+		newCreatedAt("new", now.Add(-time.Hour)),
+		newCreatedAt("week-old", now.Add(-8*24*time.Hour)),
+		newCreatedAt("month-old", now.Add(-30*24*time.Hour)),
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "missing"},
+	}
+	names := func(resources []*argoappv1.ResourceDiff) []string {
+		var names []string
+		for _, res := range resources {
+			names = append(names, res.Name)
+		}
+		return names
+	}
+
+	filtered, err := filterByAge(resources, now, 7*24*time.Hour, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"week-old", "month-old"}, names(filtered))
+
+	filtered, err = filterByAge(resources, now, 0, 7*24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"new"}, names(filtered))
+
+	filtered, err = filterByAge(resources, now, 7*24*time.Hour, 14*24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"week-old"}, names(filtered))
+}
+
+func TestFilterBySyncStatus(t *testing.T) {
+	resources := []*argoappv1.ResourceDiff{
+		newResourceDiff("apps", "v1", "Deployment", "default", "synced"),
+		newResourceDiff("apps", "v1", "Deployment", "default", "out-of-sync"),
+		newResourceDiff("apps", "v1", "Deployment", "default", "untracked"),
+	}
+	statuses := []argoappv1.ResourceStatus{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "synced", Status: argoappv1.SyncStatusCodeSynced},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "out-of-sync", Status: argoappv1.SyncStatusCodeOutOfSync},
+	}
+
+	filtered := filterBySyncStatus(resources, statuses, argoappv1.SyncStatusCodeOutOfSync)
+	if assert.Len(t, filtered, 1) {
+		assert.Equal(t, "out-of-sync", filtered[0].Name)
+	}
+	filtered = filterBySyncStatus(resources, statuses, argoappv1.SyncStatusCodeUnknown)
+	if assert.Len(t, filtered, 1) {
+		assert.Equal(t, "untracked", filtered[0].Name)
+	}
+}
+
+func TestFilterByImage(t *testing.T) {
+	newWorkload := func(group, kind, name string, podSpec string) *argoappv1.ResourceDiff {
+		res := newResourceDiff(group, "v1", kind, "default", name)
+		res.LiveState = fmt.Sprintf(`{"apiVersion": "%s/v1", "kind": "%s", "metadata": {"name": "%s", "namespace": "default"}, "spec": {"template": {"spec": %s}}}`, group, kind, name, podSpec)
+		return res
+	}
+	missing := newResourceDiff("apps", "v1", "Deployment", "default", "missing")
+	missing.LiveState = "null"
+	resources := []*argoappv1.ResourceDiff{
+		newWorkload("apps", "Deployment", "web", `{"containers": [{"name": "web", "image": "guestbook:v1.2.0"}, {"name": "proxy", "image": "envoy:1.11"}]}`),
+		newWorkload("apps", "StatefulSet", "db", `{"containers": [{"name": "db", "image": "redis:5"}]}`),
+		newWorkload("apps", "DaemonSet", "agent", `{"initContainers": [{"name": "init", "image": "guestbook:v1.2.1"}], "containers": [{"name": "agent", "image": "agent:1"}]}`),
+		newWorkload("argoproj.io", "Rollout", "canary", `{"containers": [{"name": "web", "image": "guestbook:v1.3.0"}]}`),
+		// only the pod templates of known workloads are inspected
+		newWorkload("batch", "Job", "migrate", `{"containers": [{"name": "migrate", "image": "guestbook:v1.2.0"}]}`),
+		missing,
+	}
+	names := func(pattern string) []string {
+		filtered, err := filterByImage(resources, parseImagePattern(pattern))
+		assert.NoError(t, err)
+		var names []string
+		for _, res := range filtered {
+			names = append(names, res.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"web", "agent"}, names(`^guestbook:v1\.2\.`))
+	assert.Equal(t, []string{"web", "agent", "canary"}, names("guestbook"))
+	assert.Equal(t, []string{"web"}, names("envoy"))
+	assert.Empty(t, names("nginx"))
+}
+
+func TestFilterByHealthStatus(t *testing.T) {
+	resources := []*argoappv1.ResourceDiff{
+		newResourceDiff("apps", "v1", "Deployment", "default", "healthy"),
+		newResourceDiff("apps", "v1", "Deployment", "default", "degraded"),
+		newResourceDiff("", "v1", "ConfigMap", "default", "unassessed"),
+		newResourceDiff("apps", "v1", "Deployment", "default", "untracked"),
+	}
+	statuses := []argoappv1.ResourceStatus{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "healthy", Health: &argoappv1.HealthStatus{Status: argoappv1.HealthStatusHealthy}},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "degraded", Health: &argoappv1.HealthStatus{Status: argoappv1.HealthStatusDegraded}},
+		{Kind: "ConfigMap", Namespace: "default", Name: "unassessed"},
+	}
+
+	filtered := filterByHealthStatus(resources, statuses, argoappv1.HealthStatusDegraded)
+	if assert.Len(t, filtered, 1) {
+		assert.Equal(t, "degraded", filtered[0].Name)
+	}
+	filtered = filterByHealthStatus(resources, statuses, argoappv1.HealthStatusUnknown)
+	if assert.Len(t, filtered, 2) {
+		assert.Equal(t, "unassessed", filtered[0].Name)
+		assert.Equal(t, "untracked", filtered[1].Name)
+	}
+	assert.Equal(t, argoappv1.HealthStatusMissing, parseHealthStatus("Missing"))
+}
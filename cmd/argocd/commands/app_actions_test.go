@@ -0,0 +1,406 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/localconfig"
+)
+
+// fakeAppServiceClient is an application service client which only implements the calls exercised by tests
+type fakeAppServiceClient struct {
+	applicationpkg.ApplicationServiceClient
+	lock       sync.Mutex
+	requests   []applicationpkg.ResourceActionRunRequest
+	batchSizes []int
+	runErr     func(req *applicationpkg.ResourceActionRunRequest) error
+	managed    map[string][]*argoappv1.ResourceDiff
+	listCalls  int
+	// listBatch enables the ListResourceActionsBatch call, which is unimplemented by default like in older servers
+	listBatch      bool
+	listBatchCalls int
+	// revisions are the revisions reported for resources after the action was run on them, by resource name
+	revisions map[string]int64
+	// events are the events of resources, by resource name
+	events     map[string][]corev1.Event
+	eventCalls int
+	// queries are the queries of the Get calls
+	queries []applicationpkg.ApplicationQuery
+	// apps are the applications returned by List, regardless of the query
+	apps []argoappv1.Application
+	// listQueries are the queries of the List calls
+	listQueries []applicationpkg.ApplicationQuery
+}
+
+func (c *fakeAppServiceClient) List(ctx context.Context, in *applicationpkg.ApplicationQuery, opts ...grpc.CallOption) (*argoappv1.ApplicationList, error) {
+	c.lock.Lock()
+	c.listQueries = append(c.listQueries, *in)
+	c.lock.Unlock()
+	return &argoappv1.ApplicationList{Items: c.apps}, nil
+}
+
+func (c *fakeAppServiceClient) Get(ctx context.Context, in *applicationpkg.ApplicationQuery, opts ...grpc.CallOption) (*argoappv1.Application, error) {
+	c.lock.Lock()
+	c.queries = append(c.queries, *in)
+	c.lock.Unlock()
+	return &argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: *in.Name}}, nil
+}
+
+func (c *fakeAppServiceClient) ListResourceEvents(ctx context.Context, in *applicationpkg.ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*corev1.EventList, error) {
+	c.lock.Lock()
+	c.eventCalls++
+	c.lock.Unlock()
+	return &corev1.EventList{Items: c.events[in.ResourceName]}, nil
+}
+
+func (c *fakeAppServiceClient) ManagedResources(ctx context.Context, in *applicationpkg.ResourcesQuery, opts ...grpc.CallOption) (*applicationpkg.ManagedResourcesResponse, error) {
+	return &applicationpkg.ManagedResourcesResponse{Items: c.managed[*in.ApplicationName]}, nil
+}
+
+func (c *fakeAppServiceClient) RunResourceAction(ctx context.Context, in *applicationpkg.ResourceActionRunRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionRunResponse, error) {
+	c.lock.Lock()
+	c.requests = append(c.requests, *in)
+	c.lock.Unlock()
+	if c.runErr != nil {
+		if err := c.runErr(in); err != nil {
+			return nil, err
+		}
+	}
+	return &applicationpkg.ResourceActionRunResponse{ResourceRevision: c.revisions[in.ResourceName]}, nil
+}
+
+func (c *fakeAppServiceClient) RunResourceActions(ctx context.Context, in *applicationpkg.ResourceActionsRunRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionsRunResponse, error) {
+	c.lock.Lock()
+	c.batchSizes = append(c.batchSizes, len(in.Targets))
+	c.lock.Unlock()
+	res := &applicationpkg.ResourceActionsRunResponse{}
+	for _, target := range in.Targets {
+		result := applicationpkg.ResourceActionRunResult{Target: target}
+		runRes, err := c.RunResourceAction(ctx, &applicationpkg.ResourceActionRunRequest{
+			Name:         in.Name,
+			Namespace:    target.Namespace,
+			ResourceName: target.ResourceName,
+			Group:        target.Group,
+			Kind:         target.Kind,
+			Action:       in.Action,
+		})
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.ResourceRevision = runRes.ResourceRevision
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+func (c *fakeAppServiceClient) ListResourceActions(ctx context.Context, in *applicationpkg.ApplicationResourceRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionsListResponse, error) {
+	c.lock.Lock()
+	c.listCalls++
+	c.lock.Unlock()
+	if in.Kind == "ConfigMap" {
+		return &applicationpkg.ResourceActionsListResponse{NoActionsForKind: true}, nil
+	}
+	switch in.ResourceName {
+	case "deleted":
+		st, err := status.New(codes.InvalidArgument, "deleted not found as part of application guestbook").WithDetails(&applicationpkg.ResourceActionFailure{Reason: applicationpkg.ResourceActionFailureReason_NotFound})
+		if err != nil {
+			return nil, err
+		}
+		return nil, st.Err()
+	case "forbidden":
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return &applicationpkg.ResourceActionsListResponse{Actions: []argoappv1.ResourceAction{
+		{Name: "restart", Available: true},
+		{Name: in.Namespace + "-only", Available: false},
+	}}, nil
+}
+
+func (c *fakeAppServiceClient) ListResourceActionsBatch(ctx context.Context, in *applicationpkg.ResourceActionsListBatchRequest, opts ...grpc.CallOption) (*applicationpkg.ResourceActionsListBatchResponse, error) {
+	if !c.listBatch {
+		return nil, status.Errorf(codes.Unimplemented, "unknown method ListResourceActionsBatch")
+	}
+	c.lock.Lock()
+	c.listBatchCalls++
+	c.lock.Unlock()
+	res := &applicationpkg.ResourceActionsListBatchResponse{}
+	for _, target := range in.Targets {
+		actions, err := c.ListResourceActions(ctx, &applicationpkg.ApplicationResourceRequest{
+			Name:         in.Name,
+			Namespace:    target.Namespace,
+			ResourceName: target.ResourceName,
+			Group:        target.Group,
+			Kind:         target.Kind,
+		})
+		if err != nil {
+			res.Results = append(res.Results, applicationpkg.ResourceActionsListResult{Target: target, Error: status.Convert(err).Message(), Reason: applicationpkg.ActionFailureReason(err)})
+			continue
+		}
+		res.Results = append(res.Results, applicationpkg.ResourceActionsListResult{Target: target, Actions: actions.Actions, NoActionsForKind: actions.NoActionsForKind})
+	}
+	return res, nil
+}
+
+func newConfigMap(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+	}}
+}
+
+func newDeployment(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+	}}
+}
+
+// writeTestLocalConfig writes a minimal valid local config, customized by the given function, to a temporary
+// directory and returns its path along with a function which removes it
+func writeTestLocalConfig(t *testing.T, customize func(cfg *localconfig.LocalConfig)) (string, func()) {
+	dir, err := ioutil.TempDir("", "actions-config")
+	assert.NoError(t, err)
+	cfg := localconfig.LocalConfig{
+		CurrentContext: "localhost:8080",
+		Contexts:       []localconfig.ContextRef{{Name: "localhost:8080", Server: "localhost:8080", User: "localhost:8080"}},
+		Servers:        []localconfig.Server{{Server: "localhost:8080"}},
+		Users:          []localconfig.User{{Name: "localhost:8080"}},
+	}
+	customize(&cfg)
+	configPath := filepath.Join(dir, "config")
+	assert.NoError(t, localconfig.WriteLocalConfig(cfg, configPath))
+	return configPath, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestTeeOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "actions-tee")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "out.log")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("previous run\n"), 0644))
+
+	var stdout bytes.Buffer
+	out, closeTee, err := teeOutput(&stdout, path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, _ = fmt.Fprintln(out, "Deployment/guestbook: restart")
+	closeTee()
+	assert.Equal(t, "Deployment/guestbook: restart\n", stdout.String())
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "Deployment/guestbook: restart\n", string(data))
+
+	out, closeTee, err = teeOutput(&stdout, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &stdout, out)
+	closeTee()
+
+	_, _, err = teeOutput(&stdout, filepath.Join(dir, "missing", "out.log"))
+	assert.Error(t, err)
+}
+
+func TestFormatStatusError(t *testing.T) {
+	assert.Equal(t, "connection refused", formatStatusError(fmt.Errorf("connection refused")))
+
+	st, err := status.New(codes.FailedPrecondition, "action failed").WithDetails(
+		&applicationpkg.ResourceActionFailure{Reason: applicationpkg.ResourceActionFailureReason_LuaError},
+		&errdetails.DebugInfo{StackEntries: []string{"<string>:3: in main chunk", "[G]: ?"}},
+		&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{{Type: "Status", Subject: "Rollout/guestbook", Description: "not paused"}}},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, `rpc error: code = FailedPrecondition desc = action failed
+	reason: LuaError
+	stack trace:
+		<string>:3: in main chunk
+		[G]: ?
+	precondition failed: Status Rollout/guestbook: not paused`, formatStatusError(st.Err()))
+
+	// errors without details are printed as is
+	assert.Equal(t, "rpc error: code = PermissionDenied desc = permission denied", formatStatusError(status.Error(codes.PermissionDenied, "permission denied")))
+}
+
+func TestValidateSourcePath(t *testing.T) {
+	for _, newCommand := range []func(*argocdclient.ClientOptions) *cobra.Command{NewApplicationResourceActionsListCommand, NewApplicationResourceActionsRunCommand} {
+		command := newCommand(&argocdclient.ClientOptions{})
+		assert.NoError(t, validateSourcePath(command))
+		assert.NoError(t, command.Flags().Parse([]string{"--source-path=manifests/deployment.yaml"}))
+		assert.Contains(t, fmt.Sprintf("%v", validateSourcePath(command)), "--source-path is not supported")
+	}
+}
+
+// setTestFlag sets the flag of the command to a valid value of its type
+func setTestFlag(t *testing.T, command *cobra.Command, name string) {
+	flag := command.Flags().Lookup(name)
+	if !assert.NotNil(t, flag, name) {
+		return
+	}
+	value := "x"
+	switch flag.Value.Type() {
+	case "bool":
+		value = "true"
+	case "int", "uint", "float64":
+		value = "1"
+	}
+	assert.NoError(t, command.Flags().Set(name, value))
+}
+
+func TestValidateFlags(t *testing.T) {
+	commands := map[string]struct {
+		newCommand func(clientOpts *argocdclient.ClientOptions) *cobra.Command
+		rules      flagRules
+	}{
+		"list": {NewApplicationResourceActionsListCommand, listFlagRules},
+		"run":  {NewApplicationResourceActionsRunCommand, runFlagRules},
+	}
+	for name, cmd := range commands {
+		t.Run(name, func(t *testing.T) {
+			conflicts := flagRules{conflicts: cmd.rules.conflicts}
+			for _, pair := range cmd.rules.conflicts {
+				command := cmd.newCommand(&argocdclient.ClientOptions{})
+				setTestFlag(t, command, pair[0])
+				assert.NoError(t, validateFlags(command, conflicts))
+				setTestFlag(t, command, pair[1])
+				assert.EqualError(t, validateFlags(command, conflicts), fmt.Sprintf("--%s cannot be combined with --%s. Please remove one of them", pair[0], pair[1]))
+			}
+			for _, flags := range cmd.rules.requires {
+				for _, required := range flags[1:] {
+					command := cmd.newCommand(&argocdclient.ClientOptions{})
+					setTestFlag(t, command, flags[0])
+					assert.Error(t, validateFlags(command, flagRules{requires: [][]string{flags}}), flags[0])
+					setTestFlag(t, command, required)
+					assert.NoError(t, validateFlags(command, flagRules{requires: [][]string{flags}}))
+				}
+			}
+		})
+	}
+
+	// boolean flags explicitly set to false are not considered set
+	command := NewApplicationResourceActionsRunCommand(&argocdclient.ClientOptions{})
+	assert.NoError(t, command.Flags().Set("group-results", "false"))
+	assert.NoError(t, validateFlags(command, runFlagRules))
+}
+
+func TestRefreshApplication(t *testing.T) {
+	appIf := &fakeAppServiceClient{}
+	assert.NoError(t, refreshApplication(context.Background(), appIf, "guestbook", false))
+	assert.NoError(t, refreshApplication(context.Background(), appIf, "guestbook", true))
+	if assert.Len(t, appIf.queries, 2) {
+		assert.Equal(t, string(argoappv1.RefreshTypeNormal), *appIf.queries[0].Refresh)
+		assert.Equal(t, string(argoappv1.RefreshTypeHard), *appIf.queries[1].Refresh)
+	}
+}
+
+func TestActionsKeepAliveFlags(t *testing.T) {
+	clientOpts := &argocdclient.ClientOptions{}
+	command := NewApplicationResourceActionsCommand(clientOpts)
+	assert.NoError(t, command.PersistentFlags().Parse([]string{"--keepalive-time=30s"}))
+	defer func(orig io.Writer) { cli.PromptOutput = orig }(cli.PromptOutput)
+	command.PersistentPreRun(command, nil)
+	assert.Equal(t, 30*time.Second, clientOpts.KeepAliveTime)
+	assert.Equal(t, 20*time.Second, clientOpts.KeepAliveTimeout)
+	// prompts never end up in redirected results
+	assert.Equal(t, os.Stderr, cli.PromptOutput)
+}
+
+func TestActionsQuietFlag(t *testing.T) {
+	command := NewApplicationResourceActionsCommand(&argocdclient.ClientOptions{})
+	assert.NoError(t, command.PersistentFlags().Parse([]string{"-q"}))
+	defer func(orig io.Writer) { cli.PromptOutput = orig }(cli.PromptOutput)
+	defer func(orig io.Writer) { diagnosticOutput = orig }(diagnosticOutput)
+	defer func(orig bool) { quietOutput = orig }(quietOutput)
+	defer log.SetLevel(log.GetLevel())
+	command.PersistentPreRun(command, nil)
+	assert.Equal(t, ioutil.Discard, diagnosticOutput)
+	assert.Equal(t, log.ErrorLevel, log.GetLevel())
+	// prompts are still shown
+	assert.Equal(t, os.Stderr, cli.PromptOutput)
+
+	// --quiet takes precedence over --verbose
+	appIf := &fakeAppServiceClient{}
+	assert.Equal(t, applicationpkg.ApplicationServiceClient(appIf), withVerboseLogging(appIf, true))
+	assert.Equal(t, log.ErrorLevel, log.GetLevel())
+}
+
+func TestActionErrorStackTrace(t *testing.T) {
+	st, err := status.New(codes.Internal, "error running action 'restart': <string>:3: attempt to index a non-table object(nil)").
+		WithDetails(&errdetails.DebugInfo{StackEntries: []string{"<string>:3: in main chunk", "[G]: ?"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"<string>:3: in main chunk", "[G]: ?"}, actionErrorStackTrace(st.Err()))
+	assert.Empty(t, actionErrorStackTrace(status.Errorf(codes.NotFound, "not found")))
+	assert.Empty(t, actionErrorStackTrace(nil))
+}
+
+// exitCode returns the code run exits with through fatalWithCode, or -1 if it does not exit
+func exitCode(run func()) (code int) {
+	type exited struct{ code int }
+	defer func(origExit func(int)) {
+		exit = origExit
+		if r := recover(); r != nil {
+			e, ok := r.(exited)
+			if !ok {
+				panic(r)
+			}
+			code = e.code
+		}
+	}(exit)
+	exit = func(code int) {
+		panic(exited{code})
+	}
+	run()
+	return -1
+}
+
+func TestInvalidArgsExitCode(t *testing.T) {
+	// the log level of the root command is set whenever a command is executed
+	defer func(level string) { logLevel = level }(logLevel)
+	logLevel = "info"
+	for _, args := range [][]string{
+		{"list", "guestbook", "--keepalive-time=1s"},
+		{"list", "guestbook", "--keepalive-timeout=0"},
+		{"list", "guestbook", "--grpc-compression=zstd"},
+		{"list", "guestbook", "--columns=name,bogus"},
+		{"list", "guestbook", "-o", "jsonpath={.items[}"},
+	} {
+		command := NewApplicationResourceActionsCommand(&argocdclient.ClientOptions{})
+		command.SetArgs(args)
+		assert.Equal(t, exitCodeInvalidArgs, exitCode(func() { _ = command.Execute() }), args)
+	}
+
+	dir, err := ioutil.TempDir("", "config")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	clientOpts := &argocdclient.ClientOptions{ConfigPath: filepath.Join(dir, "missing")}
+	assert.Equal(t, exitCodeInvalidArgs, exitCode(func() {
+		saveLastActionRun(clientOpts, localconfig.ActionRun{App: "guestbook", Action: "restart"})
+	}))
+	assert.Equal(t, exitCodeInvalidArgs, exitCode(func() {
+		newReplayRunCommand(clientOpts, &localconfig.ActionRun{App: "guestbook", Action: "restart", Flags: []string{"--bogus"}})
+	}))
+}
@@ -1,9 +1,17 @@
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 )
@@ -53,3 +61,223 @@ func Test_setHelmOpt(t *testing.T) {
 		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "foo", Value: "bar", ForceString: true}}, src.Helm.Parameters)
 	})
 }
+
+func newResourceDiff(group, version, kind, namespace, name string) *v1alpha1.ResourceDiff {
+	apiVersion := version
+	if group != "" {
+		apiVersion = group + "/" + version
+	}
+	return &v1alpha1.ResourceDiff{
+		Group:     group,
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+		LiveState: fmt.Sprintf(`{"apiVersion": "%s", "kind": "%s", "metadata": {"name": "%s", "namespace": "%s"}}`, apiVersion, kind, name, namespace),
+	}
+}
+
+func TestNormalizeKind(t *testing.T) {
+	assert.Equal(t, "Pod", normalizeKind("pods"))
+	assert.Equal(t, "Deployment", normalizeKind("deployments"))
+	assert.Equal(t, "Service", normalizeKind("services"))
+	assert.Equal(t, "Service", normalizeKind("Services"))
+	assert.Equal(t, "Deployment", normalizeKind("Deployment"))
+	assert.Equal(t, "MyCustomKinds", normalizeKind("MyCustomKinds"))
+}
+
+func TestParseKinds(t *testing.T) {
+	assert.Equal(t, []string{"Deployment", "StatefulSet"}, parseKinds("deployments, StatefulSet"))
+	assert.Equal(t, []string{"Deployment"}, parseKinds("Deployment,"))
+	assert.Empty(t, parseKinds(""))
+}
+
+func TestFilterResourcesByMultipleKinds(t *testing.T) {
+	resources := []*v1alpha1.ResourceDiff{
+		newResourceDiff("", "v1", "Pod", "default", "my-pod"),
+		newResourceDiff("apps", "v1", "Deployment", "default", "my-deployment"),
+		newResourceDiff("apps", "v1", "StatefulSet", "default", "my-statefulset"),
+	}
+	filtered := filterResources(&cobra.Command{}, resources, "", parseKinds("deployments,StatefulSet"), "", "", labels.Everything(), true)
+	var names []string
+	for _, obj := range filtered {
+		names = append(names, obj.GetName())
+	}
+	assert.Equal(t, []string{"my-deployment", "my-statefulset"}, names)
+
+	var out bytes.Buffer
+	explainResourceSelection(&out, &cobra.Command{}, resources, "", parseKinds("Deployment,StatefulSet"), "", "", labels.Everything())
+	assert.Contains(t, out.String(), "kind 'Pod' does not match 'Deployment,StatefulSet'")
+}
+
+func TestFilterResourcesByPluralKind(t *testing.T) {
+	resources := []*v1alpha1.ResourceDiff{
+		newResourceDiff("", "v1", "Pod", "default", "my-pod"),
+		newResourceDiff("apps", "v1", "Deployment", "default", "my-deployment"),
+		newResourceDiff("", "v1", "Service", "default", "my-service"),
+	}
+	for kind, name := range map[string]string{"pods": "my-pod", "deployments": "my-deployment", "services": "my-service"} {
+		filtered := filterResources(&cobra.Command{}, resources, "", parseKinds(kind), "", "", labels.Everything(), false)
+		if assert.Len(t, filtered, 1) {
+			assert.Equal(t, name, filtered[0].GetName())
+		}
+	}
+}
+
+func TestFilterResources(t *testing.T) {
+	missing := newResourceDiff("apps", "v1", "Deployment", "default", "missing")
+	missing.LiveState = "null"
+	withLabels := newLabeledResourceDiff("labeled", map[string]string{"app": "web"})
+	withLabels.Namespace = "web"
+	withLabels.LiveState = strings.Replace(withLabels.LiveState, `"namespace": "default"`, `"namespace": "web"`, 1)
+	resources := []*v1alpha1.ResourceDiff{
+		newResourceDiff("", "v1", "Service", "default", "guestbook"),
+		newResourceDiff("apps", "v1", "Deployment", "default", "guestbook"),
+		newResourceDiff("extensions", "v1beta1", "Deployment", "staging", "guestbook"),
+		newResourceDiff("apps", "v1", "StatefulSet", "staging", "db"),
+		missing,
+		withLabels,
+	}
+	names := func(filter ResourceFilter) []string {
+		filter.All = true
+		filtered, err := FilterResources(resources, filter)
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		var names []string
+		for _, obj := range filtered {
+			names = append(names, fmt.Sprintf("%s/%s/%s", obj.GroupVersionKind().Group, obj.GetKind(), obj.GetName()))
+		}
+		return names
+	}
+
+	// empty criteria select all resources existing in the cluster
+	assert.Equal(t, []string{"/Service/guestbook", "apps/Deployment/guestbook", "extensions/Deployment/guestbook", "apps/StatefulSet/db", "apps/Deployment/labeled"}, names(ResourceFilter{}))
+
+	// the group is only matched if requested, in which case an empty group selects the core group
+	assert.Equal(t, []string{"/Service/guestbook", "apps/Deployment/guestbook", "extensions/Deployment/guestbook"}, names(ResourceFilter{Group: "apps", Name: "guestbook"}))
+	assert.Equal(t, []string{"apps/Deployment/guestbook", "apps/StatefulSet/db", "apps/Deployment/labeled"}, names(ResourceFilter{MatchGroup: true, Group: "apps"}))
+	assert.Equal(t, []string{"/Service/guestbook"}, names(ResourceFilter{MatchGroup: true}))
+
+	// kinds may be plural, and any of them matches
+	assert.Equal(t, []string{"apps/Deployment/guestbook", "extensions/Deployment/guestbook", "apps/Deployment/labeled"}, names(ResourceFilter{Kinds: []string{"deployments"}}))
+	assert.Equal(t, []string{"/Service/guestbook", "apps/StatefulSet/db"}, names(ResourceFilter{Kinds: []string{"Service", "statefulsets"}}))
+	assert.Equal(t, []string{"apps/Deployment/guestbook"}, names(ResourceFilter{MatchGroup: true, Group: "apps", Kinds: []string{"Deployment"}, Name: "guestbook"}))
+
+	// the namespace and name are matched exactly
+	assert.Equal(t, []string{"extensions/Deployment/guestbook", "apps/StatefulSet/db"}, names(ResourceFilter{Namespace: "staging"}))
+	assert.Equal(t, []string{"extensions/Deployment/guestbook"}, names(ResourceFilter{Namespace: "staging", Name: "guestbook"}))
+
+	selector, err := labels.Parse("app=web")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"apps/Deployment/labeled"}, names(ResourceFilter{Selector: selector}))
+
+	// the resources are copies
+	filtered, err := FilterResources(resources, ResourceFilter{Name: "db"})
+	if assert.NoError(t, err) && assert.Len(t, filtered, 1) {
+		filtered[0].SetName("changed")
+		assert.Equal(t, []string{"apps/StatefulSet/db"}, names(ResourceFilter{Name: "db"}))
+	}
+
+	_, err = FilterResources(resources, ResourceFilter{Name: "missing"})
+	assert.IsType(t, noMatchError{}, err)
+	_, err = FilterResources(resources, ResourceFilter{Kinds: []string{"Pod"}, All: true})
+	assert.IsType(t, noMatchError{}, err)
+
+	// more than one match requires All
+	_, err = FilterResources(resources, ResourceFilter{Kinds: []string{"Deployment"}, Name: "guestbook"})
+	if assert.IsType(t, multipleMatchError{}, err) {
+		assert.Equal(t, []string{"default", "staging"}, err.(multipleMatchError).namespaces)
+	}
+	_, err = FilterResources(resources, ResourceFilter{Kinds: []string{"Deployment"}, Name: "guestbook", Namespace: "default"})
+	assert.NoError(t, err)
+
+	_, err = FilterResources([]*v1alpha1.ResourceDiff{{LiveState: "{"}}, ResourceFilter{})
+	assert.Error(t, err)
+}
+
+func newLabeledResourceDiff(name string, objLabels map[string]string) *v1alpha1.ResourceDiff {
+	res := newResourceDiff("apps", "v1", "Deployment", "default", name)
+	labelsJSON, _ := json.Marshal(objLabels)
+	res.LiveState = fmt.Sprintf(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "%s", "namespace": "default", "labels": %s}}`, name, labelsJSON)
+	return res
+}
+
+func TestFilterResourcesBySetBasedSelector(t *testing.T) {
+	resources := []*v1alpha1.ResourceDiff{
+		newLabeledResourceDiff("staging", map[string]string{"env": "staging", "tier": "web"}),
+		newLabeledResourceDiff("prod", map[string]string{"env": "prod"}),
+		newLabeledResourceDiff("dev", map[string]string{"env": "dev", "tier": "cache"}),
+		newLabeledResourceDiff("unlabeled", nil),
+	}
+	names := func(selector string) []string {
+		parsed, err := labels.Parse(selector)
+		assert.NoError(t, err)
+		var names []string
+		for _, obj := range filterResources(&cobra.Command{}, resources, "", nil, "", "", parsed, true) {
+			names = append(names, obj.GetName())
+		}
+		return names
+	}
+	assert.Equal(t, []string{"staging", "prod"}, names("env in (staging,prod)"))
+	assert.Equal(t, []string{"dev", "unlabeled"}, names("env notin (staging,prod)"))
+	assert.Equal(t, []string{"staging", "dev"}, names("tier"))
+	assert.Equal(t, []string{"prod", "unlabeled"}, names("!tier"))
+	assert.Equal(t, []string{"staging"}, names("env in (staging,prod),tier!=cache,tier"))
+
+	_, err := labels.Parse("env in (staging")
+	assert.Error(t, err)
+}
+
+func TestExplainResourceSelection(t *testing.T) {
+	resources := []*v1alpha1.ResourceDiff{
+		newResourceDiff("apps", "v1", "Deployment", "default", "guestbook-ui"),
+		newResourceDiff("apps", "v1", "Deployment", "other", "guestbook-ui"),
+		newResourceDiff("", "v1", "Service", "default", "guestbook-ui"),
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-api"},
+	}
+	var out bytes.Buffer
+	explainResourceSelection(&out, &cobra.Command{}, resources, "", parseKinds("deployments"), "default", "", labels.Everything())
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if assert.Len(t, lines, 5) {
+		assert.Regexp(t, `guestbook-ui\s+true\s*$`, lines[1])
+		assert.Contains(t, lines[2], "namespace 'other' does not match 'default'")
+		assert.Contains(t, lines[3], "kind 'Service' does not match 'Deployment'")
+		assert.Contains(t, lines[4], "resource does not exist in the cluster")
+	}
+}
+
+func TestResourceScope(t *testing.T) {
+	assert.Equal(t, scopeNamespaced, resourceScope(schema.GroupKind{Group: "apps", Kind: "Deployment"}, "default"))
+	assert.Equal(t, scopeCluster, resourceScope(schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}, ""))
+	// well known kinds are cluster-scoped even if a namespace is given
+	assert.Equal(t, scopeCluster, resourceScope(schema.GroupKind{Kind: "Namespace"}, "default"))
+	// other kinds are cluster-scoped if they have no namespace
+	assert.Equal(t, scopeCluster, resourceScope(schema.GroupKind{Group: "argoproj.io", Kind: "ClusterWorkflowTemplate"}, ""))
+
+	// cluster-scoped resources are not filtered by namespace
+	resources := []*v1alpha1.ResourceDiff{
+		newResourceDiff("apps", "v1", "Deployment", "default", "guestbook"),
+		newResourceDiff("apps", "v1", "Deployment", "staging", "guestbook"),
+		newResourceDiff("rbac.authorization.k8s.io", "v1", "ClusterRole", "", "guestbook"),
+	}
+	filtered, err := FilterResources(resources, ResourceFilter{Namespace: "staging", All: true})
+	assert.NoError(t, err)
+	var names []string
+	for _, obj := range filtered {
+		names = append(names, obj.GetKind()+"/"+obj.GetNamespace()+"/"+obj.GetName())
+	}
+	assert.Equal(t, []string{"Deployment/staging/guestbook", "ClusterRole//guestbook"}, names)
+}
+
+func TestPresentKinds(t *testing.T) {
+	var objs []*unstructured.Unstructured
+	for kind, count := range map[string]int{"Deployment": 3, "Service": 2, "ConfigMap": 2, "Secret": 1, "Ingress": 1, "Job": 1} {
+		for i := 0; i < count; i++ {
+			obj := &unstructured.Unstructured{}
+			obj.SetKind(kind)
+			objs = append(objs, obj)
+		}
+	}
+	assert.Equal(t, "Deployment (3), ConfigMap (2), Service (2), Ingress (1), Job (1) and 1 more", presentKinds(objs, 5))
+	assert.Equal(t, "Deployment (3), ConfigMap (2), Service (2), Ingress (1), Job (1), Secret (1)", presentKinds(objs, 10))
+}
@@ -1,5 +1,9 @@
 package common
 
+import (
+	"time"
+)
+
 // Default service addresses and URLS of Argo CD internal services
 const (
 	// DefaultRepoServerAddr is the gRPC address of the Argo CD repo server
@@ -57,6 +61,8 @@ const (
 	ArgoCDAdminUsername = "admin"
 	// ArgoCDUserAgentName is the default user-agent name used by the gRPC API client library and grpc-gateway
 	ArgoCDUserAgentName = "argocd-client"
+	// ArgoCDActionsFieldManager is the default field manager of the changes made to resources by resource actions
+	ArgoCDActionsFieldManager = "argocd-actions"
 	// AuthCookieName is the HTTP cookie name where we store our auth token
 	AuthCookieName = "argocd.token"
 	// RevisionHistoryLimit is the max number of successful sync to keep in history
@@ -67,6 +73,16 @@ const (
 	K8sClientConfigBurst = 50
 )
 
+// gRPC keepalive related constants
+const (
+	// GRPCKeepAliveEnforcementMinimum is the minimum interval at which the API server permits clients to send keepalive pings
+	GRPCKeepAliveEnforcementMinimum = 10 * time.Second
+	// DefaultGRPCKeepAliveTime is the default interval at which API clients send keepalive pings during long running calls
+	DefaultGRPCKeepAliveTime = 5 * time.Minute
+	// DefaultGRPCKeepAliveTimeout is the default time API clients wait for a keepalive ping to be acknowledged
+	DefaultGRPCKeepAliveTimeout = 20 * time.Second
+)
+
 // Dex related constants
 const (
 	// DexAPIEndpoint is the endpoint where we serve the Dex API server
@@ -112,6 +128,9 @@ const (
 	// AnnotationKeyRefresh is the annotation key which indicates that app needs to be refreshed. Removed by application controller after app is refreshed.
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh = "argocd.argoproj.io/refresh"
+	// AnnotationKeyActionReason is the annotation of the events recording resource action runs which holds the reason
+	// given for running the action
+	AnnotationKeyActionReason = "argocd.argoproj.io/action-reason"
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
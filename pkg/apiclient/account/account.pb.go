@@ -37,7 +37,7 @@ func (m *UpdatePasswordRequest) Reset()         { *m = UpdatePasswordRequest{} }
 func (m *UpdatePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdatePasswordRequest) ProtoMessage()    {}
 func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_8fa8d2c684642e77, []int{0}
+	return fileDescriptor_account_6bf749a44af886bf, []int{0}
 }
 func (m *UpdatePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,7 +90,7 @@ func (m *UpdatePasswordResponse) Reset()         { *m = UpdatePasswordResponse{}
 func (m *UpdatePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdatePasswordResponse) ProtoMessage()    {}
 func (*UpdatePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_account_8fa8d2c684642e77, []int{1}
+	return fileDescriptor_account_6bf749a44af886bf, []int{1}
 }
 func (m *UpdatePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,9 +119,124 @@ func (m *UpdatePasswordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdatePasswordResponse proto.InternalMessageInfo
 
+// CanIRequest asks whether the current user is permitted to perform an action on a resource. The fields are passed as
+// query parameters since RBAC actions and subresources may contain slashes, e.g. action/apps/Deployment/restart
+type CanIRequest struct {
+	Resource             string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Subresource          string   `protobuf:"bytes,3,opt,name=subresource,proto3" json:"subresource,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CanIRequest) Reset()         { *m = CanIRequest{} }
+func (m *CanIRequest) String() string { return proto.CompactTextString(m) }
+func (*CanIRequest) ProtoMessage()    {}
+func (*CanIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_6bf749a44af886bf, []int{2}
+}
+func (m *CanIRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanIRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CanIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanIRequest.Merge(dst, src)
+}
+func (m *CanIRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanIRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanIRequest proto.InternalMessageInfo
+
+func (m *CanIRequest) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *CanIRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *CanIRequest) GetSubresource() string {
+	if m != nil {
+		return m.Subresource
+	}
+	return ""
+}
+
+// CanIResponse is the answer to a CanIRequest, either yes or no
+type CanIResponse struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CanIResponse) Reset()         { *m = CanIResponse{} }
+func (m *CanIResponse) String() string { return proto.CompactTextString(m) }
+func (*CanIResponse) ProtoMessage()    {}
+func (*CanIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_account_6bf749a44af886bf, []int{3}
+}
+func (m *CanIResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanIResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CanIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanIResponse.Merge(dst, src)
+}
+func (m *CanIResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanIResponse proto.InternalMessageInfo
+
+func (m *CanIResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*UpdatePasswordRequest)(nil), "account.UpdatePasswordRequest")
 	proto.RegisterType((*UpdatePasswordResponse)(nil), "account.UpdatePasswordResponse")
+	proto.RegisterType((*CanIRequest)(nil), "account.CanIRequest")
+	proto.RegisterType((*CanIResponse)(nil), "account.CanIResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -137,6 +252,8 @@ const _ = grpc.SupportPackageIsVersion4
 type AccountServiceClient interface {
 	// UpdatePassword updates an account's password to a new value
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdatePasswordResponse, error)
+	// CanI checks if the current user is permitted by the RBAC policy to perform the action on the resource
+	CanI(ctx context.Context, in *CanIRequest, opts ...grpc.CallOption) (*CanIResponse, error)
 }
 
 type accountServiceClient struct {
@@ -156,11 +273,22 @@ func (c *accountServiceClient) UpdatePassword(ctx context.Context, in *UpdatePas
 	return out, nil
 }
 
+func (c *accountServiceClient) CanI(ctx context.Context, in *CanIRequest, opts ...grpc.CallOption) (*CanIResponse, error) {
+	out := new(CanIResponse)
+	err := c.cc.Invoke(ctx, "/account.AccountService/CanI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AccountService service
 
 type AccountServiceServer interface {
 	// UpdatePassword updates an account's password to a new value
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordResponse, error)
+	// CanI checks if the current user is permitted by the RBAC policy to perform the action on the resource
+	CanI(context.Context, *CanIRequest) (*CanIResponse, error)
 }
 
 func RegisterAccountServiceServer(s *grpc.Server, srv AccountServiceServer) {
@@ -185,6 +313,24 @@ func _AccountService_UpdatePassword_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_CanI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CanI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/CanI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CanI(ctx, req.(*CanIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "account.AccountService",
 	HandlerType: (*AccountServiceServer)(nil),
@@ -193,6 +339,10 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _AccountService_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "CanI",
+			Handler:    _AccountService_CanI_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/account/account.proto",
@@ -252,6 +402,72 @@ func (m *UpdatePasswordResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CanIRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanIRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Resource)))
+		i += copy(dAtA[i:], m.Resource)
+	}
+	if len(m.Action) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if len(m.Subresource) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Subresource)))
+		i += copy(dAtA[i:], m.Subresource)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CanIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanIResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -287,6 +503,40 @@ func (m *UpdatePasswordResponse) Size() (n int) {
 	return n
 }
 
+func (m *CanIRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Subresource)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CanIResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovAccount(x uint64) (n int) {
 	for {
 		n++
@@ -460,6 +710,224 @@ func (m *UpdatePasswordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CanIRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanIRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subresource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subresource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanIResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanIResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -566,27 +1034,32 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/account/account.proto", fileDescriptor_account_8fa8d2c684642e77)
-}
-
-var fileDescriptor_account_8fa8d2c684642e77 = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4e, 0x2d, 0x2a,
-	0x4b, 0x2d, 0xd2, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x81, 0xd1, 0x7a, 0x05, 0x45, 0xf9,
-	0x25, 0xf9, 0x42, 0xec, 0x50, 0xae, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x4c, 0x1f, 0xc4,
-	0x82, 0x48, 0x4b, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27,
-	0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x64, 0x95, 0x92, 0xb9, 0x44,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x8b, 0xcb, 0xf3, 0x8b, 0x52, 0x82, 0x52,
-	0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x14, 0xb8, 0xb8, 0xf3, 0x52, 0xcb, 0x61, 0xa2, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0xc8, 0x42, 0x42, 0x1a, 0x5c, 0xfc, 0xc9, 0xa5, 0x45, 0x45, 0xa9,
-	0x79, 0x25, 0x70, 0x55, 0x4c, 0x60, 0x55, 0xe8, 0xc2, 0x4a, 0x12, 0x5c, 0x62, 0xe8, 0x96, 0x14,
-	0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x75, 0x30, 0x72, 0xf1, 0x39, 0x42, 0x9c, 0x1f, 0x9c, 0x5a,
-	0x54, 0x96, 0x99, 0x9c, 0x2a, 0x54, 0xc6, 0xc5, 0x87, 0xaa, 0x58, 0x48, 0x4e, 0x0f, 0xe6, 0x61,
-	0xac, 0x4e, 0x95, 0x92, 0xc7, 0x29, 0x0f, 0xb1, 0x45, 0x49, 0xb9, 0xe9, 0xf2, 0x93, 0xc9, 0x4c,
-	0xb2, 0x52, 0x12, 0xe0, 0x40, 0x28, 0x33, 0x84, 0x07, 0x64, 0x01, 0x54, 0xa5, 0x15, 0xa3, 0x96,
-	0x93, 0xfd, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x18, 0x65,
-	0x98, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x58, 0x04, 0x0e, 0xd6,
-	0x2c, 0x30, 0x43, 0x37, 0x39, 0x45, 0xbf, 0x20, 0x3b, 0x1d, 0x64, 0x52, 0x72, 0x4e, 0x66, 0x2a,
-	0x22, 0x36, 0x92, 0xd8, 0xc0, 0x21, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x7e, 0x7f,
-	0xe8, 0xae, 0x01, 0x00, 0x00,
+	proto.RegisterFile("server/account/account.proto", fileDescriptor_account_6bf749a44af886bf)
+}
+
+var fileDescriptor_account_6bf749a44af886bf = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x3d, 0x4f, 0xeb, 0x30,
+	0x14, 0x55, 0xfa, 0xde, 0xeb, 0x7b, 0xcf, 0x45, 0x45, 0xb2, 0xda, 0x52, 0x45, 0x6d, 0xa8, 0x02,
+	0x43, 0x85, 0xd4, 0x46, 0x85, 0x8d, 0x05, 0x01, 0x13, 0x1b, 0x2a, 0x62, 0x61, 0x73, 0xdd, 0xab,
+	0x10, 0x28, 0x76, 0xf0, 0x47, 0xba, 0xf3, 0x17, 0xf8, 0x53, 0x8c, 0x48, 0xac, 0x0c, 0xa8, 0xe2,
+	0x87, 0xa0, 0xd8, 0x4e, 0x28, 0x11, 0x4c, 0xf1, 0x3d, 0xf7, 0xe6, 0x9c, 0xe3, 0xe3, 0x8b, 0x7a,
+	0x12, 0x44, 0x06, 0x22, 0x22, 0x94, 0x72, 0xcd, 0x54, 0xf1, 0x1d, 0xa7, 0x82, 0x2b, 0x8e, 0xff,
+	0xba, 0xd2, 0x6f, 0xc5, 0x3c, 0xe6, 0x06, 0x8b, 0xf2, 0x93, 0x6d, 0xfb, 0xbd, 0x98, 0xf3, 0x78,
+	0x01, 0x11, 0x49, 0x93, 0x88, 0x30, 0xc6, 0x15, 0x51, 0x09, 0x67, 0xd2, 0x76, 0x43, 0x8a, 0xda,
+	0x97, 0xe9, 0x9c, 0x28, 0x38, 0x27, 0x52, 0x2e, 0xb9, 0x98, 0x4f, 0xe1, 0x5e, 0x83, 0x54, 0x78,
+	0x80, 0x1a, 0x0c, 0x96, 0x05, 0xda, 0xf5, 0x06, 0xde, 0xf0, 0xff, 0x74, 0x1d, 0xc2, 0x43, 0xb4,
+	0x49, 0xb5, 0x10, 0xc0, 0x54, 0x39, 0x55, 0x33, 0x53, 0x55, 0x38, 0xec, 0xa2, 0x4e, 0x55, 0x44,
+	0xa6, 0x9c, 0x49, 0x08, 0x29, 0x6a, 0x9c, 0x12, 0x76, 0x56, 0x88, 0xfa, 0xe8, 0x9f, 0x00, 0xc9,
+	0xb5, 0xa0, 0xe0, 0x14, 0xcb, 0x1a, 0x77, 0x50, 0x9d, 0xd0, 0xdc, 0xba, 0x53, 0x71, 0x55, 0x6e,
+	0x54, 0xea, 0x59, 0xf9, 0xdb, 0x2f, 0x6b, 0x74, 0x0d, 0x0a, 0x77, 0xd1, 0x86, 0x15, 0xb1, 0xa2,
+	0xb8, 0x85, 0xfe, 0x64, 0x64, 0xa1, 0x0b, 0x09, 0x5b, 0xec, 0xbf, 0x7a, 0xa8, 0x79, 0x6c, 0x93,
+	0xbc, 0x00, 0x91, 0x25, 0x14, 0x70, 0x86, 0x9a, 0x5f, 0x7d, 0xe3, 0x60, 0x5c, 0x64, 0xff, 0x6d,
+	0x6a, 0xfe, 0xf6, 0x8f, 0x7d, 0x77, 0xe1, 0x9d, 0x87, 0x97, 0xf7, 0xc7, 0x5a, 0xdf, 0xef, 0x9a,
+	0xf7, 0xc8, 0x26, 0xe5, 0x9b, 0xa6, 0x6e, 0xf2, 0xd0, 0xdb, 0xc3, 0x53, 0xf4, 0x3b, 0x37, 0x8c,
+	0x5b, 0x25, 0xdb, 0x5a, 0x48, 0x7e, 0xbb, 0x82, 0x3a, 0xe6, 0xbe, 0x61, 0xde, 0xc2, 0xed, 0x2a,
+	0x33, 0x25, 0x6c, 0x94, 0x9c, 0x1c, 0x3d, 0xad, 0x02, 0xef, 0x79, 0x15, 0x78, 0x6f, 0xab, 0xc0,
+	0xbb, 0x9a, 0xc4, 0x89, 0xba, 0xd6, 0xb3, 0x31, 0xe5, 0x77, 0x11, 0x11, 0x66, 0x6b, 0x6e, 0xcc,
+	0x61, 0x44, 0xe7, 0x51, 0x7a, 0x1b, 0xe7, 0x1c, 0x74, 0x91, 0xc0, 0xe7, 0xb2, 0xcd, 0xea, 0x66,
+	0x61, 0x0e, 0x3e, 0x06, 0x00, 0x3d, 0xfe, 0x07, 0x83, 0x8d, 0x02, 0x00, 0x00,
 }
@@ -22,6 +22,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/common"
@@ -90,17 +92,43 @@ type ClientOptions struct {
 	Context    string
 	UserAgent  string
 	GRPCWeb    bool
-}
+	// KeepAliveTime is the interval at which keepalive pings are sent while calls are in progress. Keepalive pings
+	// are disabled if zero.
+	KeepAliveTime time.Duration
+	// KeepAliveTimeout is the time to wait for a keepalive ping to be acknowledged before closing the connection
+	KeepAliveTimeout time.Duration
+	// Compression is the name of the compressor used for gRPC calls, e.g. CompressionGzip. Calls are not
+	// compressed if empty.
+	Compression string
+	// ClientCertFile and ClientCertKeyFile are the paths of the certificate and key presented to servers which
+	// require TLS client authentication
+	ClientCertFile    string
+	ClientCertKeyFile string
+}
+
+// CompressionGzip is the name of the gzip compressor of gRPC calls
+const CompressionGzip = gzip.Name
 
 type client struct {
 	ServerAddr   string
 	PlainText    bool
 	Insecure     bool
 	CertPEMData  []byte
+	ClientCert   *tls.Certificate
 	AuthToken    string
 	RefreshToken string
 	UserAgent    string
 	GRPCWeb      bool
+	KeepAlive    *keepalive.ClientParameters
+	Compression  string
+
+	// authMutex guards the refresh of the auth token by concurrent requests
+	authMutex  *sync.Mutex
+	localCfg   *localconfig.LocalConfig
+	ctxName    string
+	configPath string
+	// redeemToken exchanges the refresh token for a new auth token and refresh token. Defaults to redeemRefreshToken.
+	redeemToken func() (string, string, error)
 
 	proxyMutex      *sync.Mutex
 	proxyListener   net.Listener
@@ -116,6 +144,7 @@ func NewClient(opts *ClientOptions) (Client, error) {
 		return nil, err
 	}
 	c.proxyMutex = &sync.Mutex{}
+	c.authMutex = &sync.Mutex{}
 	var ctxName string
 	if localCfg != nil {
 		configCtx, err := localCfg.ResolveContext(opts.Context)
@@ -173,6 +202,17 @@ func NewClient(opts *ClientOptions) (Client, error) {
 		}
 		c.CertPEMData = b
 	}
+	// Load the client certificate if specified from CLI flag
+	if opts.ClientCertFile != "" || opts.ClientCertKeyFile != "" {
+		if opts.ClientCertFile == "" || opts.ClientCertKeyFile == "" {
+			return nil, errors.New("Both the client certificate and the client certificate key must be specified")
+		}
+		clientCert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientCertKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		c.ClientCert = &clientCert
+	}
 	// Override insecure/plaintext options if specified from CLI
 	if opts.PlainText {
 		c.PlainText = true
@@ -183,7 +223,14 @@ func NewClient(opts *ClientOptions) (Client, error) {
 	if opts.GRPCWeb {
 		c.GRPCWeb = true
 	}
+	c.Compression = opts.Compression
+	if opts.KeepAliveTime > 0 {
+		c.KeepAlive = &keepalive.ClientParameters{Time: opts.KeepAliveTime, Timeout: opts.KeepAliveTimeout}
+	}
 	if localCfg != nil {
+		c.localCfg = localCfg
+		c.ctxName = ctxName
+		c.configPath = opts.ConfigPath
 		err = c.refreshAuthToken(localCfg, ctxName, opts.ConfigPath)
 		if err != nil {
 			return nil, err
@@ -252,7 +299,11 @@ func (c *client) HTTPClient() (*http.Client, error) {
 	}, nil
 }
 
-// refreshAuthToken refreshes a JWT auth token if it is invalid (e.g. expired)
+// authTokenRefreshMargin is how long before its expiry an auth token is refreshed, so that it does not expire while
+// a request is in flight
+const authTokenRefreshMargin = time.Minute
+
+// refreshAuthToken refreshes a JWT auth token if it is invalid (e.g. expired) or about to expire
 func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, configPath string) error {
 	if c.RefreshToken == "" {
 		// If we have no refresh token, there's no point in doing anything
@@ -270,13 +321,17 @@ func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, co
 	if err != nil {
 		return err
 	}
-	if claims.Valid() == nil {
+	if claims.Valid() == nil && claims.VerifyExpiresAt(jwt.TimeFunc().Add(authTokenRefreshMargin).Unix(), false) {
 		// token is still valid
 		return nil
 	}
 
 	log.Debug("Auth token no longer valid. Refreshing")
-	rawIDToken, refreshToken, err := c.redeemRefreshToken()
+	redeemToken := c.redeemToken
+	if redeemToken == nil {
+		redeemToken = c.redeemRefreshToken
+	}
+	rawIDToken, refreshToken, err := redeemToken()
 	if err != nil {
 		return err
 	}
@@ -294,13 +349,28 @@ func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, co
 	return nil
 }
 
+// authToken returns the auth token to send with a request, first refreshing it if it has expired. This allows long
+// running commands to outlive the expiry of the token they were started with.
+func (c *client) authToken() (string, error) {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
+	if c.localCfg != nil {
+		if err := c.refreshAuthToken(c.localCfg, c.ctxName, c.configPath); err != nil {
+			return "", err
+		}
+	}
+	return c.AuthToken, nil
+}
+
 // redeemRefreshToken performs the exchange of a refresh_token for a new id_token and refresh_token
 func (c *client) redeemRefreshToken() (string, string, error) {
-	setConn, setIf, err := c.NewSettingsClient()
+	// the settings are queried with the current token as is, since refreshing it is what is in progress
+	conn, setConn, err := c.dial(jwtCredentials{Token: c.AuthToken})
 	if err != nil {
 		return "", "", err
 	}
 	defer func() { _ = setConn.Close() }()
+	setIf := settingspkg.NewSettingsServiceClient(conn)
 	httpClient, err := c.HTTPClient()
 	if err != nil {
 		return "", "", err
@@ -342,6 +412,8 @@ func NewClientOrDie(opts *ClientOptions) Client {
 // grpc.WithPerRPCCredentials(), for authentication
 type jwtCredentials struct {
 	Token string
+	// tokenSource, if set, is called for each request to get the current token in place of Token
+	tokenSource func() (string, error)
 }
 
 func (c jwtCredentials) RequireTransportSecurity() bool {
@@ -349,12 +421,25 @@ func (c jwtCredentials) RequireTransportSecurity() bool {
 }
 
 func (c jwtCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	token := c.Token
+	if c.tokenSource != nil {
+		var err error
+		token, err = c.tokenSource()
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to refresh auth token: %v", err)
+		}
+	}
 	return map[string]string{
-		MetaDataTokenKey: c.Token,
+		MetaDataTokenKey: token,
 	}, nil
 }
 
 func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
+	return c.dial(jwtCredentials{Token: c.AuthToken, tokenSource: c.authToken})
+}
+
+// dial connects to the server, authenticating each request with the given credentials
+func (c *client) dial(endpointCredentials jwtCredentials) (*grpc.ClientConn, io.Closer, error) {
 	closers := make([]io.Closer, 0)
 	serverAddr := c.ServerAddr
 	network := "tcp"
@@ -377,15 +462,18 @@ func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
 		}
 		creds = credentials.NewTLS(tlsConfig)
 	}
-	endpointCredentials := jwtCredentials{
-		Token: c.AuthToken,
-	}
 	var dialOpts []grpc.DialOption
 	dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(endpointCredentials))
 	dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxGRPCMessageSize)))
+	if c.Compression != "" {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(c.Compression)))
+	}
 	if c.UserAgent != "" {
 		dialOpts = append(dialOpts, grpc.WithUserAgent(c.UserAgent))
 	}
+	if c.KeepAlive != nil {
+		dialOpts = append(dialOpts, grpc.WithKeepaliveParams(*c.KeepAlive))
+	}
 	conn, e := grpc_util.BlockingDial(context.Background(), network, serverAddr, creds, dialOpts...)
 	closers = append(closers, conn)
 	return conn, util.NewCloser(func() error {
@@ -409,6 +497,9 @@ func (c *client) tlsConfig() (*tls.Config, error) {
 		}
 		tlsConfig.RootCAs = cp
 	}
+	if c.ClientCert != nil {
+		tlsConfig.Certificates = []tls.Certificate{*c.ClientCert}
+	}
 	if c.Insecure {
 		tlsConfig.InsecureSkipVerify = true
 	}
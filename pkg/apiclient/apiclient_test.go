@@ -0,0 +1,221 @@
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/stats"
+
+	versionpkg "github.com/argoproj/argo-cd/pkg/apiclient/version"
+	"github.com/argoproj/argo-cd/util/localconfig"
+	tlsutil "github.com/argoproj/argo-cd/util/tls"
+)
+
+func newToken(t *testing.T, expiresAt time.Time) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{ExpiresAt: expiresAt.Unix()}).SignedString([]byte("secret"))
+	assert.NoError(t, err)
+	return token
+}
+
+func TestTokenRefreshedDuringLongRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apiclient")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	configPath := filepath.Join(dir, "config")
+
+	start := time.Now().Truncate(time.Second)
+	now := start
+	defer func(orig func() time.Time) { jwt.TimeFunc = orig }(jwt.TimeFunc)
+	jwt.TimeFunc = func() time.Time { return now }
+
+	initialToken := newToken(t, start.Add(10*time.Minute))
+	refreshedToken := newToken(t, start.Add(time.Hour))
+	localCfg := &localconfig.LocalConfig{
+		CurrentContext: "argocd",
+		Contexts:       []localconfig.ContextRef{{Name: "argocd", Server: "argocd", User: "argocd"}},
+		Servers:        []localconfig.Server{{Server: "argocd"}},
+		Users:          []localconfig.User{{Name: "argocd", AuthToken: initialToken, RefreshToken: "refresh-1"}},
+	}
+	redeemed := 0
+	c := &client{
+		AuthToken:    initialToken,
+		RefreshToken: "refresh-1",
+		authMutex:    &sync.Mutex{},
+		localCfg:     localCfg,
+		ctxName:      "argocd",
+		configPath:   configPath,
+		redeemToken: func() (string, string, error) {
+			redeemed++
+			return refreshedToken, "refresh-2", nil
+		},
+	}
+	creds := jwtCredentials{Token: c.AuthToken, tokenSource: c.authToken}
+
+	// a run over many resources, during which the initial token expires
+	var tokens []string
+	for i := 0; i < 20; i++ {
+		md, err := creds.GetRequestMetadata(context.Background())
+		if !assert.NoError(t, err) {
+			return
+		}
+		tokens = append(tokens, md[MetaDataTokenKey])
+		now = now.Add(time.Minute)
+	}
+	assert.Equal(t, 1, redeemed)
+	// the token is refreshed once it is about to expire
+	assert.Equal(t, initialToken, tokens[0])
+	assert.Equal(t, initialToken, tokens[9])
+	assert.Equal(t, refreshedToken, tokens[10])
+	assert.Equal(t, refreshedToken, tokens[19])
+
+	savedCfg, err := localconfig.ReadLocalConfig(configPath)
+	assert.NoError(t, err)
+	user, err := savedCfg.GetUser("argocd")
+	assert.NoError(t, err)
+	assert.Equal(t, refreshedToken, user.AuthToken)
+	assert.Equal(t, "refresh-2", user.RefreshToken)
+}
+
+func TestStaticTokenNotRefreshed(t *testing.T) {
+	creds := jwtCredentials{Token: "token"}
+	md, err := creds.GetRequestMetadata(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token", md[MetaDataTokenKey])
+}
+
+type fakeVersionServer struct {
+	version string
+}
+
+func (s fakeVersionServer) Version(context.Context, *empty.Empty) (*versionpkg.VersionMessage, error) {
+	return &versionpkg.VersionMessage{Version: s.version}, nil
+}
+
+// compressionRecorder is a stats handler recording the compression of incoming calls
+type compressionRecorder struct {
+	lock        sync.Mutex
+	compression []string
+}
+
+func (r *compressionRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *compressionRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if header, ok := s.(*stats.InHeader); ok {
+		r.lock.Lock()
+		r.compression = append(r.compression, header.Compression)
+		r.lock.Unlock()
+	}
+}
+
+func (r *compressionRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *compressionRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+func TestCompressedCall(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	recorder := &compressionRecorder{}
+	server := grpc.NewServer(grpc.StatsHandler(recorder))
+	// a large, well compressible response
+	version := strings.Repeat("v1.3.0 ", 100000)
+	versionpkg.RegisterVersionServiceServer(server, fakeVersionServer{version: version})
+	go func() { _ = server.Serve(ln) }()
+	defer server.Stop()
+
+	c := &client{ServerAddr: ln.Addr().String(), PlainText: true, Compression: CompressionGzip, authMutex: &sync.Mutex{}}
+	conn, versionIf, err := c.NewVersionClient()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+	res, err := versionIf.Version(context.Background(), &empty.Empty{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, version, res.Version)
+	}
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	assert.Equal(t, []string{CompressionGzip}, recorder.compression)
+}
+
+// writeKeyPair writes the PEM encoded certificate and key to files in dir and returns their paths
+func writeKeyPair(t *testing.T, dir string, name string, cert *tls.Certificate) (string, string) {
+	certPEM, keyPEM := tlsutil.EncodeX509KeyPair(*cert)
+	certPath := filepath.Join(dir, name+".crt")
+	keyPath := filepath.Join(dir, name+".key")
+	assert.NoError(t, ioutil.WriteFile(certPath, certPEM, 0600))
+	assert.NoError(t, ioutil.WriteFile(keyPath, keyPEM, 0600))
+	return certPath, keyPath
+}
+
+func TestClientCertAuth(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apiclient")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	serverCert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{Hosts: []string{"127.0.0.1"}, Organization: "Argo CD server", IsCA: true})
+	assert.NoError(t, err)
+	clientCert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{Hosts: []string{"argocd"}, Organization: "Argo CD client"})
+	assert.NoError(t, err)
+	serverCertPath, _ := writeKeyPair(t, dir, "server", serverCert)
+	clientCertPath, clientKeyPath := writeKeyPair(t, dir, "client", clientCert)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{*serverCert},
+		ClientAuth:   tls.RequireAnyClientCert,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], clientCert.Certificate[0]) {
+				return errors.New("unknown client certificate")
+			}
+			return nil
+		},
+	})))
+	versionpkg.RegisterVersionServiceServer(server, fakeVersionServer{version: "v1.3.0"})
+	go func() { _ = server.Serve(ln) }()
+	defer server.Stop()
+
+	runVersion := func(opts ClientOptions) error {
+		opts.ServerAddr = ln.Addr().String()
+		opts.CertFile = serverCertPath
+		opts.ConfigPath = filepath.Join(dir, "config")
+		c, err := NewClient(&opts)
+		if err != nil {
+			return err
+		}
+		conn, versionIf, err := c.NewVersionClient()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = conn.Close() }()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = versionIf.Version(ctx, &empty.Empty{})
+		return err
+	}
+
+	assert.NoError(t, runVersion(ClientOptions{ClientCertFile: clientCertPath, ClientCertKeyFile: clientKeyPath}))
+	// the server rejects connections without the client certificate
+	assert.Error(t, runVersion(ClientOptions{}))
+	assert.Error(t, runVersion(ClientOptions{ClientCertFile: clientCertPath}))
+}
@@ -0,0 +1,22 @@
+package application
+
+import (
+	golangproto "github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	// error details are resolved using the golang/protobuf registry, which the generated gogo types are not part of
+	golangproto.RegisterType((*ResourceActionFailure)(nil), "application.ResourceActionFailure")
+}
+
+// ActionFailureReason returns the reason attached to the error of a failed RunResourceAction call, or Unknown if the
+// error has none
+func ActionFailureReason(err error) ResourceActionFailureReason {
+	for _, detail := range status.Convert(err).Details() {
+		if failure, ok := detail.(*ResourceActionFailure); ok {
+			return failure.Reason
+		}
+	}
+	return ResourceActionFailureReason_Unknown
+}
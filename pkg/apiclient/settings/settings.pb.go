@@ -44,7 +44,7 @@ func (m *SettingsQuery) Reset()         { *m = SettingsQuery{} }
 func (m *SettingsQuery) String() string { return proto.CompactTextString(m) }
 func (*SettingsQuery) ProtoMessage()    {}
 func (*SettingsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_6148d44eb33efbca, []int{0}
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{0}
 }
 func (m *SettingsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,18 +83,21 @@ type Settings struct {
 	GoogleAnalytics    *GoogleAnalyticsConfig                `protobuf:"bytes,7,opt,name=googleAnalytics" json:"googleAnalytics,omitempty"`
 	KustomizeOptions   *v1alpha1.KustomizeOptions            `protobuf:"bytes,8,opt,name=kustomizeOptions" json:"kustomizeOptions,omitempty"`
 	// Help settings
-	Help                 *Help     `protobuf:"bytes,9,opt,name=help" json:"help,omitempty"`
-	Plugins              []*Plugin `protobuf:"bytes,10,rep,name=plugins" json:"plugins,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Help    *Help     `protobuf:"bytes,9,opt,name=help" json:"help,omitempty"`
+	Plugins []*Plugin `protobuf:"bytes,10,rep,name=plugins" json:"plugins,omitempty"`
+	// resourceActionRunOptions are the options of resource action runs the server supports. Servers which do not set
+	// it ignore any of these options
+	ResourceActionRunOptions []string `protobuf:"bytes,11,rep,name=resourceActionRunOptions" json:"resourceActionRunOptions,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
 func (m *Settings) String() string { return proto.CompactTextString(m) }
 func (*Settings) ProtoMessage()    {}
 func (*Settings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_6148d44eb33efbca, []int{1}
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{1}
 }
 func (m *Settings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,6 +196,13 @@ func (m *Settings) GetPlugins() []*Plugin {
 	return nil
 }
 
+func (m *Settings) GetResourceActionRunOptions() []string {
+	if m != nil {
+		return m.ResourceActionRunOptions
+	}
+	return nil
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -205,7 +215,7 @@ func (m *GoogleAnalyticsConfig) Reset()         { *m = GoogleAnalyticsConfig{} }
 func (m *GoogleAnalyticsConfig) String() string { return proto.CompactTextString(m) }
 func (*GoogleAnalyticsConfig) ProtoMessage()    {}
 func (*GoogleAnalyticsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_6148d44eb33efbca, []int{2}
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{2}
 }
 func (m *GoogleAnalyticsConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +273,7 @@ func (m *Help) Reset()         { *m = Help{} }
 func (m *Help) String() string { return proto.CompactTextString(m) }
 func (*Help) ProtoMessage()    {}
 func (*Help) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_6148d44eb33efbca, []int{3}
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{3}
 }
 func (m *Help) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +329,7 @@ func (m *Plugin) Reset()         { *m = Plugin{} }
 func (m *Plugin) String() string { return proto.CompactTextString(m) }
 func (*Plugin) ProtoMessage()    {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_6148d44eb33efbca, []int{4}
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{4}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,6 +365,168 @@ func (m *Plugin) GetName() string {
 	return ""
 }
 
+// ResourceActionsQuery is a query for the resource action definitions of Argo CD
+type ResourceActionsQuery struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceActionsQuery) Reset()         { *m = ResourceActionsQuery{} }
+func (m *ResourceActionsQuery) String() string { return proto.CompactTextString(m) }
+func (*ResourceActionsQuery) ProtoMessage()    {}
+func (*ResourceActionsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{5}
+}
+func (m *ResourceActionsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceActionsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceActionsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResourceActionsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionsQuery.Merge(dst, src)
+}
+func (m *ResourceActionsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceActionsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceActionsQuery proto.InternalMessageInfo
+
+// ResourceActionsEntry holds the resource actions defined for a kind of resources by a single source
+type ResourceActionsEntry struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind  string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// source is "builtin" for the actions shipped with Argo CD, or "customization" for the actions configured in the
+	// resource customizations of the argocd-cm ConfigMap, which take precedence over the built-in ones
+	Source               string                   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Actions              v1alpha1.ResourceActions `protobuf:"bytes,4,opt,name=actions" json:"actions"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ResourceActionsEntry) Reset()         { *m = ResourceActionsEntry{} }
+func (m *ResourceActionsEntry) String() string { return proto.CompactTextString(m) }
+func (*ResourceActionsEntry) ProtoMessage()    {}
+func (*ResourceActionsEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{6}
+}
+func (m *ResourceActionsEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceActionsEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceActionsEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResourceActionsEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionsEntry.Merge(dst, src)
+}
+func (m *ResourceActionsEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceActionsEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionsEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceActionsEntry proto.InternalMessageInfo
+
+func (m *ResourceActionsEntry) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ResourceActionsEntry) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceActionsEntry) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ResourceActionsEntry) GetActions() v1alpha1.ResourceActions {
+	if m != nil {
+		return m.Actions
+	}
+	return v1alpha1.ResourceActions{}
+}
+
+// ResourceActionsList is the list of the resource action definitions of Argo CD
+type ResourceActionsList struct {
+	Items                []ResourceActionsEntry `protobuf:"bytes,1,rep,name=items" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ResourceActionsList) Reset()         { *m = ResourceActionsList{} }
+func (m *ResourceActionsList) String() string { return proto.CompactTextString(m) }
+func (*ResourceActionsList) ProtoMessage()    {}
+func (*ResourceActionsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{7}
+}
+func (m *ResourceActionsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceActionsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceActionsList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResourceActionsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionsList.Merge(dst, src)
+}
+func (m *ResourceActionsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceActionsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceActionsList proto.InternalMessageInfo
+
+func (m *ResourceActionsList) GetItems() []ResourceActionsEntry {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type DexConfig struct {
 	Connectors           []*Connector `protobuf:"bytes,1,rep,name=connectors" json:"connectors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -366,7 +538,7 @@ func (m *DexConfig) Reset()         { *m = DexConfig{} }
 func (m *DexConfig) String() string { return proto.CompactTextString(m) }
 func (*DexConfig) ProtoMessage()    {}
 func (*DexConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_6148d44eb33efbca, []int{5}
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{8}
 }
 func (m *DexConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +586,7 @@ func (m *Connector) Reset()         { *m = Connector{} }
 func (m *Connector) String() string { return proto.CompactTextString(m) }
 func (*Connector) ProtoMessage()    {}
 func (*Connector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_6148d44eb33efbca, []int{6}
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{9}
 }
 func (m *Connector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +645,7 @@ func (m *OIDCConfig) Reset()         { *m = OIDCConfig{} }
 func (m *OIDCConfig) String() string { return proto.CompactTextString(m) }
 func (*OIDCConfig) ProtoMessage()    {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_settings_6148d44eb33efbca, []int{7}
+	return fileDescriptor_settings_5e577cad4e98b8b6, []int{10}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,6 +723,9 @@ func init() {
 	proto.RegisterType((*GoogleAnalyticsConfig)(nil), "cluster.GoogleAnalyticsConfig")
 	proto.RegisterType((*Help)(nil), "cluster.Help")
 	proto.RegisterType((*Plugin)(nil), "cluster.Plugin")
+	proto.RegisterType((*ResourceActionsQuery)(nil), "cluster.ResourceActionsQuery")
+	proto.RegisterType((*ResourceActionsEntry)(nil), "cluster.ResourceActionsEntry")
+	proto.RegisterType((*ResourceActionsList)(nil), "cluster.ResourceActionsList")
 	proto.RegisterType((*DexConfig)(nil), "cluster.DexConfig")
 	proto.RegisterType((*Connector)(nil), "cluster.Connector")
 	proto.RegisterType((*OIDCConfig)(nil), "cluster.OIDCConfig")
@@ -570,6 +745,9 @@ const _ = grpc.SupportPackageIsVersion4
 type SettingsServiceClient interface {
 	// Get returns Argo CD settings
 	Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error)
+	// ListResourceActions returns all resource action definitions, whether built-in or configured as resource
+	// customizations, regardless of the resources managed by applications
+	ListResourceActions(ctx context.Context, in *ResourceActionsQuery, opts ...grpc.CallOption) (*ResourceActionsList, error)
 }
 
 type settingsServiceClient struct {
@@ -589,11 +767,23 @@ func (c *settingsServiceClient) Get(ctx context.Context, in *SettingsQuery, opts
 	return out, nil
 }
 
+func (c *settingsServiceClient) ListResourceActions(ctx context.Context, in *ResourceActionsQuery, opts ...grpc.CallOption) (*ResourceActionsList, error) {
+	out := new(ResourceActionsList)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/ListResourceActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SettingsService service
 
 type SettingsServiceServer interface {
 	// Get returns Argo CD settings
 	Get(context.Context, *SettingsQuery) (*Settings, error)
+	// ListResourceActions returns all resource action definitions, whether built-in or configured as resource
+	// customizations, regardless of the resources managed by applications
+	ListResourceActions(context.Context, *ResourceActionsQuery) (*ResourceActionsList, error)
 }
 
 func RegisterSettingsServiceServer(s *grpc.Server, srv SettingsServiceServer) {
@@ -618,6 +808,24 @@ func _SettingsService_Get_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingsService_ListResourceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceActionsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).ListResourceActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/ListResourceActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).ListResourceActions(ctx, req.(*ResourceActionsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SettingsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cluster.SettingsService",
 	HandlerType: (*SettingsServiceServer)(nil),
@@ -626,6 +834,10 @@ var _SettingsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _SettingsService_Get_Handler,
 		},
+		{
+			MethodName: "ListResourceActions",
+			Handler:    _SettingsService_ListResourceActions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/settings/settings.proto",
@@ -779,6 +991,21 @@ func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.ResourceActionRunOptions) > 0 {
+		for _, s := range m.ResourceActionRunOptions {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -882,6 +1109,107 @@ func (m *Plugin) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ResourceActionsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceActionsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionsEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintSettings(dAtA, i, uint64(m.Actions.Size()))
+	n7, err := m.Actions.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceActionsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionsList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSettings(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *DexConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1022,11 +1350,11 @@ func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintSettings(dAtA, i, uint64(v.Size()))
-				n7, err := v.MarshalTo(dAtA[i:])
+				n8, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n7
+				i += n8
 			}
 		}
 	}
@@ -1107,6 +1435,12 @@ func (m *Settings) Size() (n int) {
 			n += 1 + l + sovSettings(uint64(l))
 		}
 	}
+	if len(m.ResourceActionRunOptions) > 0 {
+		for _, s := range m.ResourceActionRunOptions {
+			l = len(s)
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1159,6 +1493,53 @@ func (m *Plugin) Size() (n int) {
 	return n
 }
 
+func (m *ResourceActionsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionsEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = m.Actions.Size()
+	n += 1 + l + sovSettings(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionsList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DexConfig) Size() (n int) {
 	var l int
 	_ = l
@@ -1725,11 +2106,40 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSettings(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceActionRunOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceActionRunOptions = append(m.ResourceActionRunOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthSettings
@@ -2036,6 +2446,307 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResourceActionsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionsEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionsEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Actions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ResourceActionsEntry{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DexConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2652,63 +3363,73 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/settings/settings.proto", fileDescriptor_settings_6148d44eb33efbca)
-}
-
-var fileDescriptor_settings_6148d44eb33efbca = []byte{
-	// 860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xd6, 0xc4, 0x89, 0x7f, 0xca, 0x64, 0x9d, 0x34, 0x10, 0x0d, 0xd6, 0xca, 0x36, 0x73, 0x58,
-	0x99, 0x03, 0x33, 0xc4, 0x7b, 0x00, 0x21, 0x10, 0x60, 0x7b, 0xb5, 0x6b, 0x12, 0x14, 0xe8, 0xdd,
-	0x70, 0x40, 0x42, 0x51, 0x67, 0xa6, 0x99, 0x34, 0x9e, 0x74, 0x8f, 0xba, 0x7b, 0xcc, 0x9a, 0x23,
-	0x37, 0x8e, 0x88, 0x97, 0xe2, 0x88, 0xc4, 0xdd, 0x42, 0x16, 0x37, 0x5e, 0x02, 0x4d, 0xcf, 0x4f,
-	0x26, 0xb6, 0xb5, 0x42, 0xda, 0x5b, 0x75, 0x55, 0x7d, 0x55, 0xd5, 0xd5, 0x5f, 0x55, 0x43, 0x4f,
-	0x51, 0xb9, 0xa0, 0xd2, 0x53, 0x54, 0x6b, 0xc6, 0x43, 0x55, 0x0a, 0x6e, 0x2c, 0x85, 0x16, 0xa8,
-	0xe1, 0x47, 0x89, 0xd2, 0x54, 0x76, 0xdf, 0x0a, 0x45, 0x28, 0x8c, 0xce, 0x4b, 0xa5, 0xcc, 0xdc,
-	0x7d, 0x18, 0x0a, 0x11, 0x46, 0xd4, 0x23, 0x31, 0xf3, 0x08, 0xe7, 0x42, 0x13, 0xcd, 0x04, 0xcf,
-	0xc1, 0xdd, 0x59, 0xc8, 0xf4, 0x4d, 0x72, 0xed, 0xfa, 0xe2, 0xd6, 0x23, 0xd2, 0xc0, 0x7f, 0x34,
-	0xc2, 0xfb, 0x7e, 0xe0, 0xc5, 0xf3, 0x30, 0x85, 0x29, 0x8f, 0xc4, 0x71, 0xc4, 0x7c, 0x03, 0xf4,
-	0x16, 0xa7, 0x24, 0x8a, 0x6f, 0xc8, 0xa9, 0x17, 0x52, 0x4e, 0x25, 0xd1, 0x34, 0xc8, 0x43, 0x7d,
-	0xfa, 0xaa, 0x50, 0x9b, 0x77, 0x10, 0x2c, 0xf0, 0x3d, 0x3f, 0x22, 0xec, 0x36, 0xaf, 0xc4, 0xe9,
-	0xc0, 0xe1, 0xf3, 0xdc, 0xfa, 0x4d, 0x42, 0xe5, 0xd2, 0xf9, 0xf7, 0x00, 0x9a, 0x85, 0x06, 0xbd,
-	0x03, 0xb5, 0x44, 0x46, 0xb6, 0x35, 0xb0, 0x86, 0xad, 0x71, 0x63, 0xbd, 0xea, 0xd7, 0x2e, 0xf1,
-	0x39, 0x4e, 0x75, 0xe8, 0x03, 0x68, 0x05, 0xf4, 0xe5, 0x44, 0xf0, 0x1f, 0x58, 0x68, 0xef, 0x0d,
-	0xac, 0x61, 0x7b, 0x84, 0xdc, 0xbc, 0x27, 0xee, 0xb4, 0xb0, 0xe0, 0x3b, 0x27, 0x34, 0x01, 0x48,
-	0xf3, 0xe7, 0x90, 0x9a, 0x81, 0xbc, 0x59, 0x42, 0x2e, 0x66, 0xd3, 0x49, 0x66, 0x1a, 0x3f, 0x58,
-	0xaf, 0xfa, 0x70, 0x77, 0xc6, 0x15, 0x18, 0x1a, 0x40, 0x9b, 0xc4, 0xf1, 0x39, 0xb9, 0xa6, 0xd1,
-	0x19, 0x5d, 0xda, 0xfb, 0x69, 0x65, 0xb8, 0xaa, 0x42, 0xdf, 0xc2, 0xb1, 0xa4, 0x4a, 0x24, 0xd2,
-	0xa7, 0x17, 0x0b, 0x2a, 0x25, 0x0b, 0xa8, 0xb2, 0x0f, 0x06, 0xb5, 0x61, 0x7b, 0x34, 0x2c, 0xb3,
-	0x15, 0x37, 0x74, 0xf1, 0xa6, 0xeb, 0x13, 0xae, 0xe5, 0x12, 0x6f, 0x87, 0x40, 0x2e, 0x20, 0xa5,
-	0x89, 0x4e, 0xd4, 0x98, 0x04, 0x21, 0x7d, 0xc2, 0xc9, 0x75, 0x44, 0x03, 0xbb, 0x3e, 0xb0, 0x86,
-	0x4d, 0xbc, 0xc3, 0x82, 0x9e, 0x41, 0x27, 0xe3, 0xc0, 0x17, 0x9c, 0x44, 0x4b, 0xcd, 0x7c, 0x65,
-	0x37, 0xcc, 0x9d, 0x7b, 0x65, 0x15, 0x4f, 0xef, 0xdb, 0xf3, 0xeb, 0x6e, 0xc2, 0xd0, 0x4f, 0x70,
-	0x34, 0x4f, 0x94, 0x16, 0xb7, 0xec, 0x67, 0x7a, 0x11, 0x1b, 0x1e, 0xd9, 0x4d, 0x13, 0xea, 0xcc,
-	0xbd, 0x7b, 0x7d, 0xb7, 0x78, 0x7d, 0x23, 0x5c, 0xf9, 0x81, 0x1b, 0xcf, 0x43, 0x37, 0x25, 0x92,
-	0x5b, 0x21, 0x92, 0x5b, 0x10, 0xc9, 0x3d, 0xdb, 0x08, 0x89, 0xb7, 0x92, 0xa0, 0x77, 0x61, 0xff,
-	0x86, 0x46, 0xb1, 0xdd, 0x32, 0xc9, 0x0e, 0xcb, 0xba, 0x9f, 0xd1, 0x28, 0xc6, 0xc6, 0x84, 0xde,
-	0x83, 0x46, 0x1c, 0x25, 0x21, 0xe3, 0xca, 0x06, 0xd3, 0xe3, 0x4e, 0xe9, 0xf5, 0xb5, 0xd1, 0xe3,
-	0xc2, 0xde, 0xfd, 0xcd, 0x82, 0x93, 0xdd, 0xed, 0x46, 0x47, 0x50, 0x9b, 0xd3, 0x65, 0xc6, 0x33,
-	0x9c, 0x8a, 0x88, 0xc0, 0xc1, 0x82, 0x44, 0x09, 0xcd, 0xa9, 0xf5, 0x3a, 0x17, 0xdd, 0xcc, 0x89,
-	0xb3, 0xc8, 0x1f, 0xef, 0x7d, 0x64, 0x39, 0x57, 0xf0, 0xf6, 0xce, 0x47, 0x40, 0x3d, 0x00, 0x2d,
-	0x89, 0x3f, 0x67, 0x3c, 0x9c, 0x4d, 0xf3, 0xc2, 0x2a, 0x1a, 0xf4, 0x08, 0x1e, 0x10, 0x2e, 0xf8,
-	0x32, 0x6d, 0xd7, 0xa5, 0xa2, 0x52, 0x99, 0x42, 0x9b, 0x78, 0x43, 0xeb, 0x7c, 0x02, 0xfb, 0x69,
-	0xb7, 0x90, 0x0d, 0x0d, 0xff, 0x86, 0xe8, 0xcb, 0x62, 0x9a, 0x70, 0x71, 0x44, 0x5d, 0x68, 0xa6,
-	0xe2, 0x0b, 0xfa, 0x52, 0x9b, 0x18, 0x2d, 0x5c, 0x9e, 0x9d, 0x87, 0x50, 0xcf, 0xba, 0x88, 0x10,
-	0xec, 0x73, 0x72, 0x4b, 0x73, 0xb0, 0x91, 0x9d, 0xcf, 0xa0, 0x55, 0x0e, 0x1a, 0x1a, 0x01, 0xf8,
-	0x82, 0x73, 0xea, 0x6b, 0x21, 0x95, 0x6d, 0x99, 0xb7, 0xb8, 0x1b, 0xc8, 0x49, 0x61, 0xc2, 0x15,
-	0x2f, 0xe7, 0x31, 0xb4, 0x4a, 0xc3, 0xae, 0x0c, 0xa9, 0x4e, 0x2f, 0x63, 0x9a, 0xd7, 0x65, 0x64,
-	0xe7, 0xd7, 0x1a, 0x54, 0x86, 0x73, 0x27, 0xec, 0x04, 0xea, 0x4c, 0xa9, 0x84, 0xca, 0x1c, 0x98,
-	0x9f, 0xd0, 0x10, 0x9a, 0x7e, 0xc4, 0x28, 0xd7, 0xb3, 0xa9, 0x99, 0xff, 0xd6, 0xf8, 0x8d, 0xf5,
-	0xaa, 0xdf, 0x9c, 0xe4, 0x3a, 0x5c, 0x5a, 0xd1, 0x29, 0xb4, 0xfd, 0x88, 0x15, 0x86, 0x6c, 0xcc,
-	0xc7, 0x9d, 0xf5, 0xaa, 0xdf, 0x9e, 0x9c, 0xcf, 0x4a, 0xff, 0xaa, 0x4f, 0x9a, 0x54, 0xf9, 0x22,
-	0xce, 0x87, 0xbd, 0x85, 0xf3, 0x13, 0xba, 0x82, 0x43, 0x16, 0xbc, 0x10, 0x73, 0xca, 0x27, 0x66,
-	0xf1, 0xd9, 0x75, 0xd3, 0x9b, 0x47, 0x3b, 0x36, 0x8f, 0x3b, 0xab, 0x3a, 0x1a, 0x6a, 0x8e, 0x8f,
-	0xd7, 0xab, 0xfe, 0xe1, 0x6c, 0x5a, 0xd1, 0xe3, 0xfb, 0xf1, 0xba, 0x4b, 0x40, 0xdb, 0xb8, 0x1d,
-	0x94, 0xfe, 0xea, 0x3e, 0xa5, 0x3f, 0x7c, 0x25, 0xa5, 0xb3, 0xcd, 0xed, 0x96, 0x9f, 0x4e, 0xba,
-	0x02, 0x5d, 0x13, 0xbf, 0x42, 0xdf, 0xd1, 0xf7, 0xd0, 0x29, 0x36, 0xd9, 0x73, 0x2a, 0x17, 0xcc,
-	0xa7, 0xe8, 0x4b, 0xa8, 0x3d, 0xa5, 0x1a, 0x9d, 0x6c, 0xad, 0x3a, 0xb3, 0xde, 0xbb, 0xc7, 0x5b,
-	0x7a, 0xc7, 0xfe, 0xe5, 0xaf, 0x7f, 0x7e, 0xdf, 0x43, 0xe8, 0xc8, 0x7c, 0x56, 0x8b, 0xd3, 0xf2,
-	0xbb, 0x18, 0x7f, 0xfe, 0xc7, 0xba, 0x67, 0xfd, 0xb9, 0xee, 0x59, 0x7f, 0xaf, 0x7b, 0xd6, 0x77,
-	0xa3, 0xff, 0xf1, 0x69, 0x65, 0x0f, 0x58, 0x46, 0xb8, 0xae, 0x9b, 0x5f, 0xe6, 0xf1, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x3c, 0x78, 0x11, 0xd5, 0x4e, 0x07, 0x00, 0x00,
+	proto.RegisterFile("server/settings/settings.proto", fileDescriptor_settings_5e577cad4e98b8b6)
+}
+
+var fileDescriptor_settings_5e577cad4e98b8b6 = []byte{
+	// 1011 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x67, 0xe3, 0xc4, 0x7f, 0x9e, 0x49, 0x93, 0x4c, 0x82, 0xb5, 0x58, 0xc1, 0x76, 0x17, 0xa9,
+	0x72, 0x0f, 0xdd, 0x25, 0xee, 0x01, 0xa8, 0x40, 0x50, 0xdb, 0x55, 0xeb, 0x26, 0x28, 0x65, 0xda,
+	0x70, 0xe0, 0x12, 0x4d, 0x76, 0x87, 0xcd, 0xd4, 0xeb, 0xd9, 0xd5, 0xee, 0xac, 0xa9, 0x91, 0xb8,
+	0x70, 0xe3, 0x88, 0xf8, 0x10, 0x7c, 0x11, 0x0e, 0x3d, 0x22, 0x71, 0x45, 0x16, 0xb2, 0xf8, 0x20,
+	0x68, 0x67, 0x67, 0x37, 0x1b, 0xdb, 0xa9, 0x90, 0xe8, 0xed, 0xcd, 0x7b, 0xef, 0xf7, 0x7b, 0x33,
+	0xef, 0xbd, 0x79, 0x33, 0xd0, 0x8a, 0x68, 0x38, 0xa5, 0xa1, 0x15, 0x51, 0x21, 0x18, 0x77, 0xa3,
+	0x5c, 0x30, 0x83, 0xd0, 0x17, 0x3e, 0xaa, 0xd8, 0x5e, 0x1c, 0x09, 0x1a, 0x36, 0x0f, 0x5c, 0xdf,
+	0xf5, 0xa5, 0xce, 0x4a, 0xa4, 0xd4, 0xdc, 0x3c, 0x74, 0x7d, 0xdf, 0xf5, 0xa8, 0x45, 0x02, 0x66,
+	0x11, 0xce, 0x7d, 0x41, 0x04, 0xf3, 0xb9, 0x02, 0x37, 0x47, 0x2e, 0x13, 0x97, 0xf1, 0x85, 0x69,
+	0xfb, 0x13, 0x8b, 0x84, 0x12, 0xfe, 0x52, 0x0a, 0xf7, 0x6c, 0xc7, 0x0a, 0xc6, 0x6e, 0x02, 0x8b,
+	0x2c, 0x12, 0x04, 0x1e, 0xb3, 0x25, 0xd0, 0x9a, 0x1e, 0x11, 0x2f, 0xb8, 0x24, 0x47, 0x96, 0x4b,
+	0x39, 0x0d, 0x89, 0xa0, 0x8e, 0xa2, 0xfa, 0xfc, 0x4d, 0x54, 0xcb, 0x67, 0xf0, 0x99, 0x63, 0x5b,
+	0xb6, 0x47, 0xd8, 0x44, 0xed, 0xc4, 0xd8, 0x81, 0xed, 0xe7, 0xca, 0xfa, 0x75, 0x4c, 0xc3, 0x99,
+	0xf1, 0x5b, 0x19, 0xaa, 0x99, 0x06, 0xbd, 0x0f, 0xa5, 0x38, 0xf4, 0x74, 0xad, 0xa3, 0x75, 0x6b,
+	0xfd, 0xca, 0x62, 0xde, 0x2e, 0x9d, 0xe1, 0x13, 0x9c, 0xe8, 0xd0, 0x47, 0x50, 0x73, 0xe8, 0xab,
+	0x81, 0xcf, 0xbf, 0x63, 0xae, 0xbe, 0xd1, 0xd1, 0xba, 0xf5, 0x1e, 0x32, 0x55, 0x4e, 0xcc, 0x61,
+	0x66, 0xc1, 0x57, 0x4e, 0x68, 0x00, 0x90, 0xc4, 0x57, 0x90, 0x92, 0x84, 0xec, 0xe7, 0x90, 0xd3,
+	0xd1, 0x70, 0x90, 0x9a, 0xfa, 0xb7, 0x16, 0xf3, 0x36, 0x5c, 0xad, 0x71, 0x01, 0x86, 0x3a, 0x50,
+	0x27, 0x41, 0x70, 0x42, 0x2e, 0xa8, 0x77, 0x4c, 0x67, 0xfa, 0x66, 0xb2, 0x33, 0x5c, 0x54, 0xa1,
+	0x6f, 0x60, 0x2f, 0xa4, 0x91, 0x1f, 0x87, 0x36, 0x3d, 0x9d, 0xd2, 0x30, 0x64, 0x0e, 0x8d, 0xf4,
+	0xad, 0x4e, 0xa9, 0x5b, 0xef, 0x75, 0xf3, 0x68, 0xd9, 0x09, 0x4d, 0xbc, 0xec, 0xfa, 0x88, 0x8b,
+	0x70, 0x86, 0x57, 0x29, 0x90, 0x09, 0x28, 0x12, 0x44, 0xc4, 0x51, 0x9f, 0x38, 0x2e, 0x7d, 0xc4,
+	0xc9, 0x85, 0x47, 0x1d, 0xbd, 0xdc, 0xd1, 0xba, 0x55, 0xbc, 0xc6, 0x82, 0x9e, 0xc0, 0x4e, 0xda,
+	0x03, 0x0f, 0x39, 0xf1, 0x66, 0x82, 0xd9, 0x91, 0x5e, 0x91, 0x67, 0x6e, 0xe5, 0xbb, 0x78, 0x7c,
+	0xdd, 0xae, 0x8e, 0xbb, 0x0c, 0x43, 0xdf, 0xc3, 0xee, 0x38, 0x8e, 0x84, 0x3f, 0x61, 0x3f, 0xd0,
+	0xd3, 0x40, 0xf6, 0x91, 0x5e, 0x95, 0x54, 0xc7, 0xe6, 0x55, 0xf5, 0xcd, 0xac, 0xfa, 0x52, 0x38,
+	0xb7, 0x1d, 0x33, 0x18, 0xbb, 0x66, 0xd2, 0x48, 0x66, 0xa1, 0x91, 0xcc, 0xac, 0x91, 0xcc, 0xe3,
+	0x25, 0x4a, 0xbc, 0x12, 0x04, 0xdd, 0x86, 0xcd, 0x4b, 0xea, 0x05, 0x7a, 0x4d, 0x06, 0xdb, 0xce,
+	0xf7, 0xfd, 0x84, 0x7a, 0x01, 0x96, 0x26, 0x74, 0x17, 0x2a, 0x81, 0x17, 0xbb, 0x8c, 0x47, 0x3a,
+	0xc8, 0x1c, 0xef, 0xe4, 0x5e, 0xcf, 0xa4, 0x1e, 0x67, 0x76, 0xf4, 0x00, 0xf4, 0x2c, 0xab, 0x0f,
+	0xed, 0x24, 0x00, 0x8e, 0x79, 0x76, 0x9c, 0x7a, 0xa7, 0xd4, 0xad, 0xe1, 0x1b, 0xed, 0xcd, 0x5f,
+	0x34, 0x68, 0xac, 0x2f, 0x15, 0xda, 0x85, 0xd2, 0x98, 0xce, 0xd2, 0x1e, 0xc5, 0x89, 0x88, 0x08,
+	0x6c, 0x4d, 0x89, 0x17, 0x53, 0x7d, 0xe3, 0x7f, 0x27, 0x69, 0x39, 0x26, 0x4e, 0x99, 0x1f, 0x6c,
+	0x7c, 0xa2, 0x19, 0xe7, 0xf0, 0xde, 0xda, 0x02, 0xa2, 0x16, 0x80, 0x08, 0x89, 0x3d, 0x66, 0xdc,
+	0x1d, 0x0d, 0xd5, 0xc6, 0x0a, 0x1a, 0x74, 0x07, 0x6e, 0x11, 0xee, 0xf3, 0x59, 0x92, 0xea, 0xb3,
+	0x88, 0x86, 0x91, 0xdc, 0x68, 0x15, 0x2f, 0x69, 0x8d, 0xcf, 0x60, 0x33, 0xc9, 0x34, 0xd2, 0xa1,
+	0x62, 0x5f, 0x12, 0x71, 0x96, 0xdd, 0x44, 0x9c, 0x2d, 0x51, 0x13, 0xaa, 0x89, 0xf8, 0x82, 0xbe,
+	0x12, 0x92, 0xa3, 0x86, 0xf3, 0xb5, 0x71, 0x08, 0xe5, 0xb4, 0x02, 0x08, 0xc1, 0x26, 0x27, 0x13,
+	0xaa, 0xc0, 0x52, 0x36, 0x1a, 0x70, 0x80, 0xaf, 0x25, 0x5b, 0x5d, 0xff, 0xdf, 0xb5, 0x15, 0x43,
+	0x9a, 0xe6, 0x03, 0xd8, 0x72, 0x43, 0x3f, 0x0e, 0x14, 0x4b, 0xba, 0x48, 0xa8, 0xc7, 0x8c, 0x3b,
+	0x2a, 0xb8, 0x94, 0x51, 0x03, 0xca, 0x29, 0x5e, 0xde, 0xf1, 0x1a, 0x56, 0x2b, 0xf4, 0x12, 0x2a,
+	0x24, 0x65, 0x94, 0xd7, 0xb6, 0xde, 0x7b, 0xfa, 0x16, 0x0a, 0xa3, 0xf6, 0xd8, 0xdf, 0x7c, 0x3d,
+	0x6f, 0xbf, 0x83, 0xb3, 0x00, 0xc6, 0x33, 0xd8, 0x5f, 0xf2, 0x38, 0x61, 0x91, 0x40, 0x9f, 0xc2,
+	0x16, 0x13, 0x74, 0x12, 0xe9, 0x9a, 0xec, 0xd5, 0x0f, 0xf2, 0x5e, 0x5d, 0x77, 0x64, 0xc5, 0x99,
+	0x22, 0x8c, 0x2f, 0xa0, 0x96, 0x4f, 0x35, 0xd4, 0x03, 0xb0, 0x7d, 0xce, 0xa9, 0x2d, 0xfc, 0x30,
+	0x23, 0xbb, 0x9a, 0x7e, 0x83, 0xcc, 0x84, 0x0b, 0x5e, 0xc6, 0x7d, 0xa8, 0xe5, 0x86, 0x75, 0x25,
+	0x49, 0x74, 0x62, 0x16, 0xd0, 0x2c, 0x97, 0x89, 0x6c, 0xfc, 0x5c, 0x82, 0xc2, 0x24, 0x5c, 0x0b,
+	0x6b, 0x40, 0x99, 0x45, 0x51, 0x4c, 0x43, 0x05, 0x54, 0x2b, 0xd4, 0x85, 0xaa, 0xed, 0x31, 0xca,
+	0xc5, 0x68, 0x98, 0x16, 0xa2, 0xff, 0xee, 0x62, 0xde, 0xae, 0x0e, 0x94, 0x0e, 0xe7, 0x56, 0x74,
+	0x04, 0x75, 0xdb, 0x63, 0x99, 0x21, 0x9d, 0xa9, 0xfd, 0x9d, 0xc5, 0xbc, 0x5d, 0x1f, 0x9c, 0x8c,
+	0x72, 0xff, 0xa2, 0x8f, 0xac, 0xb1, 0xed, 0x07, 0x6a, 0xb2, 0xd6, 0xb0, 0x5a, 0xa1, 0x73, 0xd8,
+	0x66, 0xce, 0x0b, 0x7f, 0x4c, 0xf9, 0x40, 0xbe, 0x32, 0x7a, 0x59, 0xe6, 0xe6, 0xce, 0x9a, 0x31,
+	0x6f, 0x8e, 0x8a, 0x8e, 0x69, 0xc6, 0xf7, 0x16, 0xf3, 0xf6, 0xf6, 0x68, 0x58, 0xd0, 0xe3, 0xeb,
+	0x7c, 0xcd, 0x19, 0xa0, 0x55, 0xdc, 0x9a, 0x19, 0xf0, 0xd5, 0xf5, 0x19, 0xf0, 0xf1, 0x1b, 0x5b,
+	0x2d, 0x7d, 0x26, 0xcd, 0xfc, 0x85, 0x4f, 0xde, 0x1b, 0x53, 0xf2, 0x17, 0xee, 0x7b, 0xef, 0x2f,
+	0x0d, 0x76, 0xb2, 0x77, 0xe3, 0x39, 0x0d, 0xa7, 0xcc, 0xa6, 0xe8, 0x29, 0x94, 0x1e, 0x53, 0x81,
+	0x1a, 0x2b, 0x0f, 0x8b, 0xbc, 0x4d, 0xcd, 0xbd, 0x15, 0xbd, 0xa1, 0xff, 0xf4, 0xe7, 0x3f, 0xbf,
+	0x6e, 0x20, 0xb4, 0x2b, 0xbf, 0x06, 0xd3, 0xa3, 0xfc, 0x71, 0x46, 0x3f, 0xc2, 0x7e, 0xd2, 0xa4,
+	0x4b, 0xad, 0x88, 0x6e, 0x6c, 0xd2, 0x34, 0xc4, 0xe1, 0x4d, 0xe6, 0x84, 0xcb, 0xb8, 0x2b, 0xa3,
+	0x7d, 0x88, 0x6e, 0x2f, 0x47, 0xb3, 0xb2, 0x51, 0x7b, 0x4f, 0x5d, 0x99, 0xfe, 0x97, 0xaf, 0x17,
+	0x2d, 0xed, 0x8f, 0x45, 0x4b, 0xfb, 0x7b, 0xd1, 0xd2, 0xbe, 0xed, 0xfd, 0x87, 0x1f, 0x4a, 0xda,
+	0x40, 0x39, 0xe5, 0x45, 0x59, 0x7e, 0x29, 0xee, 0xff, 0x3b, 0x00, 0x0d, 0x6f, 0x84, 0xe9, 0x3b,
+	0x09, 0x00, 0x00,
 }
@@ -4183,6 +4183,25 @@ func (m *ResourceAction) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i++
+	if len(m.Conditions) > 0 {
+		for _, s := range m.Conditions {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Inverse)))
+	i += copy(dAtA[i:], m.Inverse)
 	return i, nil
 }
 
@@ -6043,6 +6062,14 @@ func (m *ResourceAction) Size() (n int) {
 		}
 	}
 	n += 2
+	if len(m.Conditions) > 0 {
+		for _, s := range m.Conditions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Inverse)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -7060,6 +7087,8 @@ func (this *ResourceAction) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Params:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Params), "ResourceActionParam", "ResourceActionParam", 1), `&`, ``, 1) + `,`,
 		`Available:` + fmt.Sprintf("%v", this.Available) + `,`,
+		`Conditions:` + fmt.Sprintf("%v", this.Conditions) + `,`,
+		`Inverse:` + fmt.Sprintf("%v", this.Inverse) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14523,6 +14552,64 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Available = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inverse", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inverse = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18763,300 +18850,300 @@ func init() {
 }
 
 var fileDescriptor_generated_7f736b60d21ede25 = []byte{
-	// 4711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x5d, 0x8c, 0x1c, 0xd9,
-	0x55, 0xb0, 0xab, 0xbb, 0xa7, 0xbb, 0xe7, 0xcc, 0x8f, 0x3d, 0x77, 0xd7, 0x9b, 0xce, 0x68, 0xe3,
-	0xb1, 0xca, 0x4a, 0xb2, 0xfb, 0x65, 0xd3, 0xf3, 0xad, 0xe5, 0x80, 0x43, 0x24, 0x96, 0xe9, 0x99,
-	0xb1, 0x3d, 0xf6, 0xcc, 0x78, 0xf6, 0xf6, 0x78, 0x2d, 0x6d, 0x42, 0xd8, 0x72, 0xf5, 0xed, 0xee,
-	0xf2, 0x74, 0x57, 0xd5, 0x56, 0x55, 0xb7, 0x3d, 0x86, 0x84, 0x0d, 0x90, 0x28, 0x04, 0x16, 0x21,
-	0x10, 0x4f, 0x28, 0x12, 0x20, 0x5e, 0x88, 0x78, 0x41, 0x48, 0xe4, 0x81, 0x27, 0xf2, 0x00, 0xfb,
-	0x18, 0xd0, 0x0a, 0x45, 0x04, 0x8d, 0x58, 0x87, 0x07, 0x44, 0x1e, 0x00, 0x21, 0x5e, 0xfc, 0x84,
-	0xee, 0xff, 0xad, 0xea, 0x6e, 0xcf, 0xd8, 0x5d, 0x76, 0xa4, 0xf0, 0xd6, 0x75, 0xce, 0xa9, 0x73,
-	0xee, 0x3d, 0xf7, 0xde, 0x73, 0xcf, 0x5f, 0x35, 0x6c, 0x75, 0xbc, 0xa4, 0x3b, 0xb8, 0x53, 0x77,
-	0x83, 0xfe, 0xaa, 0x13, 0x75, 0x82, 0x30, 0x0a, 0xee, 0xb2, 0x1f, 0x9f, 0x75, 0x5b, 0xab, 0xe1,
-	0x41, 0x67, 0xd5, 0x09, 0xbd, 0x78, 0xd5, 0x09, 0xc3, 0x9e, 0xe7, 0x3a, 0x89, 0x17, 0xf8, 0xab,
-	0xc3, 0xd7, 0x9d, 0x5e, 0xd8, 0x75, 0x5e, 0x5f, 0xed, 0x10, 0x9f, 0x44, 0x4e, 0x42, 0x5a, 0xf5,
-	0x30, 0x0a, 0x92, 0x00, 0x7d, 0x5e, 0xb3, 0xaa, 0x4b, 0x56, 0xec, 0xc7, 0x2f, 0xb9, 0xad, 0x7a,
-	0x78, 0xd0, 0xa9, 0x53, 0x56, 0x75, 0x83, 0x55, 0x5d, 0xb2, 0x5a, 0xfe, 0xac, 0x31, 0x8a, 0x4e,
-	0xd0, 0x09, 0x56, 0x19, 0xc7, 0x3b, 0x83, 0x36, 0x7b, 0x62, 0x0f, 0xec, 0x17, 0x97, 0xb4, 0x6c,
-	0x1f, 0x5c, 0x8e, 0xeb, 0x5e, 0x40, 0xc7, 0xb6, 0xea, 0x06, 0x11, 0x59, 0x1d, 0x8e, 0x8c, 0x66,
-	0xf9, 0x92, 0xa6, 0xe9, 0x3b, 0x6e, 0xd7, 0xf3, 0x49, 0x74, 0xa8, 0x27, 0xd4, 0x27, 0x89, 0x33,
-	0xee, 0xad, 0xd5, 0x49, 0x6f, 0x45, 0x03, 0x3f, 0xf1, 0xfa, 0x64, 0xe4, 0x85, 0x9f, 0x39, 0xee,
-	0x85, 0xd8, 0xed, 0x92, 0xbe, 0x93, 0x7d, 0xcf, 0x7e, 0x17, 0x16, 0xd6, 0x6e, 0x37, 0xd7, 0x06,
-	0x49, 0x77, 0x3d, 0xf0, 0xdb, 0x5e, 0x07, 0x7d, 0x0e, 0xe6, 0xdc, 0xde, 0x20, 0x4e, 0x48, 0xb4,
-	0xeb, 0xf4, 0x49, 0xcd, 0x3a, 0x6f, 0xbd, 0x32, 0xdb, 0x78, 0xe1, 0x83, 0xa3, 0x95, 0x53, 0x0f,
-	0x8f, 0x56, 0xe6, 0xd6, 0x35, 0x0a, 0x9b, 0x74, 0xe8, 0x55, 0xa8, 0x44, 0x41, 0x8f, 0xac, 0xe1,
-	0xdd, 0x5a, 0x81, 0xbd, 0x72, 0x5a, 0xbc, 0x52, 0xc1, 0x1c, 0x8c, 0x25, 0xde, 0xfe, 0xa1, 0x05,
-	0xb0, 0x16, 0x86, 0x7b, 0x51, 0x70, 0x97, 0xb8, 0x09, 0x7a, 0x07, 0xaa, 0x54, 0x0b, 0x2d, 0x27,
-	0x71, 0x98, 0xb4, 0xb9, 0x8b, 0xff, 0xbf, 0xce, 0x27, 0x53, 0x37, 0x27, 0xa3, 0x57, 0x8e, 0x52,
-	0xd7, 0x87, 0xaf, 0xd7, 0x6f, 0xde, 0xa1, 0xef, 0xef, 0x90, 0xc4, 0x69, 0x20, 0x21, 0x0c, 0x34,
-	0x0c, 0x2b, 0xae, 0xe8, 0x00, 0x4a, 0x71, 0x48, 0x5c, 0x36, 0xb0, 0xb9, 0x8b, 0x5b, 0xf5, 0xa7,
-	0xde, 0x1f, 0x75, 0x3d, 0xec, 0x66, 0x48, 0xdc, 0xc6, 0xbc, 0x10, 0x5b, 0xa2, 0x4f, 0x98, 0x09,
-	0xb1, 0xff, 0xc9, 0x82, 0x45, 0x4d, 0xb6, 0xed, 0xc5, 0x09, 0xfa, 0xd2, 0xc8, 0x0c, 0xeb, 0x27,
-	0x9b, 0x21, 0x7d, 0x9b, 0xcd, 0xef, 0x8c, 0x10, 0x54, 0x95, 0x10, 0x63, 0x76, 0x77, 0x61, 0xc6,
-	0x4b, 0x48, 0x3f, 0xae, 0x15, 0xce, 0x17, 0x5f, 0x99, 0xbb, 0xb8, 0x99, 0xcb, 0xf4, 0x1a, 0x0b,
-	0x42, 0xe2, 0xcc, 0x16, 0xe5, 0x8d, 0xb9, 0x08, 0xfb, 0x6f, 0x2a, 0xe6, 0xe4, 0xe8, 0xac, 0xd1,
-	0xeb, 0x30, 0x17, 0x07, 0x83, 0xc8, 0x25, 0x98, 0x84, 0x41, 0x5c, 0xb3, 0xce, 0x17, 0xe9, 0xe2,
-	0xd3, 0xbd, 0xd2, 0xd4, 0x60, 0x6c, 0xd2, 0xa0, 0xdf, 0xb2, 0x60, 0xbe, 0x45, 0xe2, 0xc4, 0xf3,
-	0x99, 0x7c, 0x39, 0xf2, 0x37, 0xa7, 0x1b, 0xb9, 0x04, 0x6e, 0x68, 0xce, 0x8d, 0x17, 0xc5, 0x2c,
-	0xe6, 0x0d, 0x60, 0x8c, 0x53, 0xc2, 0xe9, 0x86, 0x6f, 0x91, 0xd8, 0x8d, 0xbc, 0x90, 0x3e, 0xd7,
-	0x8a, 0xe9, 0x0d, 0xbf, 0xa1, 0x51, 0xd8, 0xa4, 0x43, 0x07, 0x30, 0x43, 0x37, 0x74, 0x5c, 0x2b,
-	0xb1, 0xc1, 0x5f, 0x99, 0x62, 0xf0, 0x42, 0x9d, 0xf4, 0xa0, 0x68, 0xbd, 0xd3, 0xa7, 0x18, 0x73,
-	0x19, 0xe8, 0x7d, 0x0b, 0x6a, 0xe2, 0xb4, 0x61, 0xc2, 0x55, 0x79, 0xbb, 0xeb, 0x25, 0xa4, 0xe7,
-	0xc5, 0x49, 0x6d, 0x86, 0x0d, 0x60, 0xf5, 0x64, 0x5b, 0xea, 0x6a, 0x14, 0x0c, 0xc2, 0x1b, 0x9e,
-	0xdf, 0x6a, 0x9c, 0x17, 0x92, 0x6a, 0xeb, 0x13, 0x18, 0xe3, 0x89, 0x22, 0xd1, 0xef, 0x5b, 0xb0,
-	0xec, 0x3b, 0x7d, 0x12, 0x87, 0x0e, 0x5d, 0x54, 0x8e, 0x6e, 0xf4, 0x1c, 0xf7, 0x80, 0x8d, 0xa8,
-	0xfc, 0x74, 0x23, 0xb2, 0xc5, 0x88, 0x96, 0x77, 0x27, 0xb2, 0xc6, 0x8f, 0x11, 0x8b, 0xfe, 0xc8,
-	0x82, 0xa5, 0x20, 0x0a, 0xbb, 0x8e, 0x4f, 0x5a, 0x12, 0x1b, 0xd7, 0x2a, 0xec, 0xc4, 0x7d, 0x71,
-	0x8a, 0xf5, 0xb9, 0x99, 0xe5, 0xb9, 0x13, 0xf8, 0x5e, 0x12, 0x44, 0x4d, 0x92, 0x24, 0x9e, 0xdf,
-	0x89, 0x1b, 0x67, 0x1f, 0x1e, 0xad, 0x2c, 0x8d, 0x50, 0xe1, 0xd1, 0xc1, 0xa0, 0xf7, 0x2c, 0x98,
-	0xeb, 0x3b, 0x9e, 0x9f, 0x10, 0xdf, 0xf1, 0x5d, 0x52, 0xab, 0xb2, 0xc1, 0xed, 0x4c, 0xbf, 0x79,
-	0x76, 0x34, 0x53, 0x7e, 0xfa, 0x0c, 0x00, 0x36, 0x45, 0xda, 0x7f, 0x5b, 0x84, 0x39, 0xe3, 0xb8,
-	0x3c, 0x07, 0xfb, 0xdb, 0x4b, 0xd9, 0xdf, 0xeb, 0xf9, 0x1c, 0xf3, 0x49, 0x06, 0x18, 0x25, 0x50,
-	0x8e, 0x13, 0x27, 0x19, 0xc4, 0xec, 0x28, 0xcf, 0x5d, 0xdc, 0xce, 0x49, 0x1e, 0xe3, 0xd9, 0x58,
-	0x14, 0x12, 0xcb, 0xfc, 0x19, 0x0b, 0x59, 0xe8, 0x5d, 0x98, 0x0d, 0x42, 0x7a, 0xb3, 0x52, 0x1b,
-	0x52, 0x62, 0x82, 0x37, 0xa6, 0xd9, 0x72, 0x92, 0x57, 0x63, 0xe1, 0xe1, 0xd1, 0xca, 0xac, 0x7a,
-	0xc4, 0x5a, 0x8a, 0xed, 0xc2, 0x8b, 0xc6, 0xf8, 0xd6, 0x03, 0xbf, 0xe5, 0xb1, 0x05, 0x3d, 0x0f,
-	0xa5, 0xe4, 0x30, 0x94, 0x57, 0xb7, 0x52, 0xd1, 0xfe, 0x61, 0x48, 0x30, 0xc3, 0xd0, 0xcb, 0xba,
-	0x4f, 0xe2, 0xd8, 0xe9, 0x90, 0xec, 0x65, 0xbd, 0xc3, 0xc1, 0x58, 0xe2, 0xed, 0x77, 0xe1, 0xa5,
-	0xf1, 0xb6, 0x15, 0x7d, 0x0a, 0xca, 0x31, 0x89, 0x86, 0x24, 0x12, 0x82, 0xb4, 0x66, 0x18, 0x14,
-	0x0b, 0x2c, 0x5a, 0x85, 0x59, 0x75, 0x66, 0x85, 0xb8, 0x25, 0x41, 0x3a, 0xab, 0x0f, 0xba, 0xa6,
-	0xb1, 0xff, 0xd9, 0x82, 0xd3, 0x86, 0xcc, 0xe7, 0x70, 0x85, 0x1e, 0xa4, 0xaf, 0xd0, 0x2b, 0xf9,
-	0xec, 0x98, 0x09, 0x77, 0xe8, 0x5f, 0x96, 0x61, 0xc9, 0xdc, 0x57, 0xcc, 0x32, 0x30, 0xff, 0x89,
-	0x84, 0xc1, 0x2d, 0xbc, 0x2d, 0xd4, 0xa9, 0xfd, 0x27, 0x0e, 0xc6, 0x12, 0x4f, 0xd7, 0x37, 0x74,
-	0x92, 0xae, 0xd0, 0xa5, 0x5a, 0xdf, 0x3d, 0x27, 0xe9, 0x62, 0x86, 0x41, 0x3f, 0x0f, 0x8b, 0x89,
-	0x13, 0x75, 0x48, 0x82, 0xc9, 0xd0, 0x8b, 0xe5, 0x8e, 0x9c, 0x6d, 0xbc, 0x24, 0x68, 0x17, 0xf7,
-	0x53, 0x58, 0x9c, 0xa1, 0x46, 0x3e, 0x94, 0xba, 0xa4, 0xd7, 0x17, 0xa6, 0x73, 0x2f, 0xa7, 0x03,
-	0xc4, 0x26, 0x7a, 0x8d, 0xf4, 0xfa, 0x8d, 0x2a, 0x1d, 0x2f, 0xfd, 0x85, 0x99, 0x1c, 0xf4, 0x6b,
-	0x16, 0xcc, 0x1e, 0x0c, 0xe2, 0x24, 0xe8, 0x7b, 0x0f, 0xa4, 0x4d, 0xbc, 0x95, 0xa7, 0xd4, 0x1b,
-	0x92, 0x39, 0x3f, 0x4e, 0xea, 0x11, 0x6b, 0xb1, 0xe8, 0x01, 0x54, 0x0e, 0xe2, 0xc0, 0xf7, 0x49,
-	0x52, 0x9b, 0x65, 0x23, 0x68, 0xe6, 0x3a, 0x02, 0xce, 0xba, 0x31, 0x47, 0x97, 0x54, 0x3c, 0x60,
-	0x29, 0x90, 0x29, 0xa0, 0xe5, 0x45, 0xc4, 0x4d, 0x82, 0xe8, 0xb0, 0x06, 0xf9, 0x2b, 0x60, 0x43,
-	0x32, 0xe7, 0x0a, 0x50, 0x8f, 0x58, 0x8b, 0x45, 0x43, 0x28, 0x87, 0xbd, 0x41, 0xc7, 0xf3, 0x6b,
-	0x73, 0x6c, 0x00, 0x38, 0xcf, 0x01, 0xec, 0x31, 0xce, 0x0d, 0xa0, 0x06, 0x82, 0xff, 0xc6, 0x42,
-	0x1a, 0xba, 0x00, 0x33, 0x6e, 0xd7, 0x89, 0x92, 0xda, 0x3c, 0xdb, 0xa4, 0xea, 0xd4, 0xac, 0x53,
-	0x20, 0xe6, 0x38, 0xfb, 0xef, 0x2c, 0x58, 0x9e, 0x3c, 0x2b, 0x7e, 0x7c, 0xdc, 0x41, 0x14, 0x73,
-	0xb3, 0x57, 0x35, 0x8f, 0x0f, 0x03, 0x63, 0x89, 0x47, 0x5f, 0x85, 0xca, 0x5d, 0xb1, 0xce, 0x85,
-	0xfc, 0xd7, 0xf9, 0xba, 0x58, 0x67, 0x25, 0xff, 0xba, 0x5c, 0x6b, 0x21, 0xd4, 0xfe, 0xd3, 0x02,
-	0x9c, 0x1d, 0x7b, 0x2c, 0x50, 0x1d, 0x60, 0xe8, 0xf4, 0x06, 0xe4, 0x8a, 0x47, 0xfd, 0x4a, 0xee,
-	0x49, 0x2f, 0xd2, 0x5b, 0xf5, 0x2d, 0x05, 0xc5, 0x06, 0x05, 0xfa, 0x15, 0x80, 0xd0, 0x89, 0x9c,
-	0x3e, 0x49, 0x48, 0x24, 0x6d, 0xd7, 0xb5, 0x29, 0x26, 0x43, 0x07, 0xb1, 0x27, 0x19, 0xea, 0x3b,
-	0x5d, 0x81, 0x62, 0x6c, 0xc8, 0xa3, 0x7e, 0x73, 0x44, 0x7a, 0xc4, 0x89, 0x09, 0x0b, 0x14, 0x33,
-	0x7e, 0x33, 0xd6, 0x28, 0x6c, 0xd2, 0xd1, 0x6b, 0x83, 0x4d, 0x21, 0x16, 0x36, 0x49, 0x5d, 0x1b,
-	0x6c, 0x92, 0x31, 0x16, 0x58, 0xfb, 0x7f, 0x2c, 0xa8, 0x4d, 0xd2, 0x2e, 0x0a, 0xa1, 0x42, 0xee,
-	0x27, 0x6f, 0x39, 0x11, 0x57, 0xd3, 0x74, 0x51, 0x8f, 0x60, 0xfa, 0x96, 0x13, 0xe9, 0x55, 0xdb,
-	0xe4, 0xdc, 0xb1, 0x14, 0x83, 0x3a, 0x50, 0x4a, 0x7a, 0x4e, 0x1e, 0x41, 0x96, 0x21, 0x4e, 0xdf,
-	0xcd, 0xdb, 0x6b, 0x31, 0x66, 0x02, 0xec, 0x7f, 0x18, 0x37, 0x6f, 0x61, 0x30, 0xa8, 0xce, 0x89,
-	0x3f, 0xf4, 0xa2, 0xc0, 0xef, 0x13, 0x3f, 0xc9, 0x06, 0xe7, 0x9b, 0x1a, 0x85, 0x4d, 0x3a, 0xf4,
-	0xab, 0x63, 0x36, 0xca, 0x8d, 0x29, 0xa6, 0x20, 0x86, 0x73, 0xe2, 0xbd, 0x62, 0xff, 0xb8, 0x30,
-	0xe6, 0xf4, 0x2a, 0x2b, 0x8c, 0x2e, 0x02, 0xd0, 0xeb, 0x7f, 0x2f, 0x22, 0x6d, 0xef, 0xbe, 0x98,
-	0x95, 0x62, 0xb9, 0xab, 0x30, 0xd8, 0xa0, 0x42, 0x97, 0xa0, 0xec, 0xf5, 0x9d, 0x0e, 0xa1, 0x6e,
-	0x1e, 0x3d, 0x28, 0x2f, 0xd3, 0x3d, 0xb4, 0xc5, 0x20, 0x8f, 0x8e, 0x56, 0x16, 0x15, 0x73, 0x06,
-	0xc2, 0x82, 0x16, 0xfd, 0xb1, 0x05, 0xf3, 0x6e, 0xd0, 0xef, 0x07, 0xfe, 0xb6, 0x73, 0x87, 0xf4,
-	0x64, 0xf4, 0xd6, 0x79, 0x26, 0x97, 0x4d, 0x7d, 0xdd, 0x90, 0xb4, 0xe9, 0x27, 0xd1, 0xa1, 0x0e,
-	0x48, 0x4d, 0x14, 0x4e, 0x0d, 0x69, 0xf9, 0x0d, 0x58, 0x1a, 0x79, 0x11, 0x9d, 0x81, 0xe2, 0x01,
-	0x39, 0xe4, 0xba, 0xc1, 0xf4, 0x27, 0x7a, 0x11, 0x66, 0xd8, 0x51, 0xe1, 0x7e, 0x00, 0xe6, 0x0f,
-	0x3f, 0x57, 0xb8, 0x6c, 0xd9, 0x7f, 0x68, 0xc1, 0xc7, 0x26, 0x18, 0x60, 0xea, 0x3c, 0xf8, 0x3a,
-	0xaf, 0xa3, 0x36, 0x20, 0x3b, 0xa7, 0x0c, 0x83, 0xbe, 0x0c, 0x45, 0xe2, 0x0f, 0xc5, 0x2e, 0x59,
-	0x9f, 0x42, 0x31, 0x9b, 0xfe, 0x90, 0x4f, 0xba, 0xf2, 0xf0, 0x68, 0xa5, 0xb8, 0xe9, 0x0f, 0x31,
-	0x65, 0x6c, 0x7f, 0x77, 0x26, 0xe5, 0xde, 0x35, 0xa5, 0xcf, 0xce, 0x46, 0x29, 0x9c, 0xbb, 0xed,
-	0x3c, 0xd7, 0xc3, 0xf0, 0x4c, 0x79, 0x12, 0x42, 0xc8, 0x42, 0xdf, 0xb4, 0x58, 0xe8, 0x2f, 0x3d,
-	0x5a, 0x71, 0x1d, 0x3c, 0x83, 0x34, 0x84, 0x99, 0x4d, 0x90, 0x40, 0x6c, 0x8a, 0xa6, 0xf7, 0x57,
-	0xc8, 0x03, 0x39, 0x61, 0x48, 0x95, 0x25, 0x92, 0xc9, 0x01, 0x89, 0x47, 0x03, 0x80, 0xf8, 0xd0,
-	0x77, 0xf7, 0x82, 0x9e, 0xe7, 0x1e, 0x8a, 0x50, 0x63, 0x1a, 0x7b, 0xd4, 0x54, 0xcc, 0xf8, 0x65,
-	0xa3, 0x9f, 0xb1, 0x21, 0x08, 0x7d, 0xdb, 0x82, 0x25, 0xaf, 0xe3, 0x07, 0x11, 0xd9, 0xf0, 0xda,
-	0x6d, 0x12, 0x11, 0x9f, 0x06, 0xd7, 0x3c, 0xf7, 0xb0, 0x3f, 0x85, 0x78, 0x19, 0x1b, 0x6f, 0x65,
-	0x79, 0x37, 0x3e, 0x2e, 0x54, 0xb0, 0x34, 0x82, 0xc2, 0xa3, 0x23, 0x41, 0x0e, 0x94, 0x3c, 0xbf,
-	0x1d, 0x88, 0xdc, 0xc3, 0x1b, 0x53, 0x8c, 0x68, 0xcb, 0x6f, 0x07, 0xfa, 0x64, 0xd0, 0x27, 0xcc,
-	0x58, 0xdb, 0xff, 0x5d, 0x4d, 0x7b, 0xee, 0x3c, 0xf2, 0x7b, 0x00, 0xb3, 0x91, 0x4a, 0x36, 0xf0,
-	0xdb, 0x68, 0x2b, 0x07, 0x7d, 0x88, 0x78, 0x53, 0x85, 0x4a, 0x3a, 0xad, 0xa0, 0xc5, 0xd1, 0x5b,
-	0x89, 0x2e, 0x91, 0xd8, 0xb9, 0xd3, 0xee, 0x02, 0x21, 0x52, 0x07, 0xd5, 0x87, 0x3e, 0x0d, 0xaa,
-	0x0f, 0x7d, 0x17, 0x05, 0x50, 0xee, 0x12, 0xa7, 0x97, 0x74, 0x45, 0x50, 0x7d, 0x75, 0x2a, 0x37,
-	0x83, 0x32, 0xca, 0xc6, 0xd3, 0x1c, 0x8a, 0x85, 0x18, 0x34, 0x80, 0x4a, 0xd7, 0x8b, 0x99, 0x3b,
-	0xcc, 0x4d, 0xf4, 0xf5, 0xa9, 0x74, 0xca, 0x03, 0x9b, 0x6b, 0x9c, 0xa3, 0x3e, 0x5c, 0x02, 0x80,
-	0xa5, 0x2c, 0xf4, 0xeb, 0x16, 0x80, 0x2b, 0x23, 0x69, 0xb9, 0xbd, 0x6f, 0xe6, 0x63, 0x11, 0x54,
-	0x84, 0xae, 0xef, 0x36, 0x05, 0x8a, 0xb1, 0x21, 0x16, 0xbd, 0x03, 0xf3, 0x11, 0x71, 0x03, 0xdf,
-	0xf5, 0x7a, 0xa4, 0xb5, 0x96, 0xd4, 0xca, 0x4c, 0xe7, 0xff, 0xef, 0x64, 0x11, 0xef, 0xbe, 0xd7,
-	0x27, 0x8d, 0x33, 0xf4, 0x8e, 0xc1, 0x06, 0x0f, 0x9c, 0xe2, 0x88, 0xbe, 0x6e, 0xc1, 0xa2, 0xca,
-	0x24, 0xd0, 0xa5, 0x20, 0x22, 0xd8, 0xdb, 0xca, 0x23, 0x69, 0xc1, 0x18, 0x36, 0x10, 0x8d, 0x34,
-	0xd3, 0x30, 0x9c, 0x11, 0x8a, 0xde, 0x06, 0x08, 0xee, 0xb0, 0x44, 0x01, 0x9d, 0x67, 0xf5, 0x89,
-	0xe7, 0xb9, 0xc8, 0x93, 0x4e, 0x92, 0x03, 0x36, 0xb8, 0xa1, 0x1b, 0x00, 0xfc, 0x9c, 0xec, 0x1f,
-	0x86, 0x84, 0xc5, 0x74, 0xb3, 0x8d, 0xcf, 0x48, 0xcd, 0x37, 0x15, 0xe6, 0xd1, 0xd1, 0xca, 0xa8,
-	0x3f, 0xce, 0x92, 0x25, 0xc6, 0xeb, 0xe8, 0x3e, 0x54, 0xe2, 0x41, 0xbf, 0xef, 0xa8, 0xf0, 0x6c,
-	0x27, 0xa7, 0x2b, 0x8a, 0x33, 0xd5, 0x5b, 0x52, 0x00, 0xb0, 0x14, 0x67, 0xfb, 0x80, 0x46, 0xe9,
-	0xd1, 0x25, 0x98, 0x27, 0xf7, 0x13, 0x12, 0xf9, 0x4e, 0xef, 0x16, 0xde, 0x96, 0xd1, 0x02, 0x5b,
-	0xf6, 0x4d, 0x03, 0x8e, 0x53, 0x54, 0xc8, 0x56, 0x4e, 0x53, 0x81, 0xd1, 0x83, 0x76, 0x9a, 0xa4,
-	0x8b, 0x64, 0x7f, 0xa3, 0x90, 0xba, 0x9f, 0xf7, 0x23, 0x42, 0x50, 0x0f, 0x66, 0xfc, 0xa0, 0xa5,
-	0xec, 0xdb, 0xd5, 0x1c, 0xec, 0xdb, 0x6e, 0xd0, 0x32, 0xb2, 0xdd, 0xf4, 0x29, 0xc6, 0x5c, 0x08,
-	0xfa, 0x0d, 0x0b, 0x16, 0x64, 0xea, 0x94, 0x21, 0x84, 0x33, 0x92, 0x9b, 0xd8, 0xb3, 0x42, 0xec,
-	0xc2, 0x4d, 0x53, 0x0a, 0x4e, 0x0b, 0xb5, 0x7f, 0x64, 0xa5, 0x02, 0xb5, 0xdb, 0x4e, 0xe2, 0x76,
-	0x37, 0x87, 0xd4, 0x9f, 0xbe, 0x91, 0xca, 0xb0, 0xfd, 0xac, 0x99, 0x61, 0x7b, 0x74, 0xb4, 0xf2,
-	0xe9, 0x49, 0xa5, 0xb8, 0x7b, 0x94, 0x43, 0x9d, 0xb1, 0x30, 0x92, 0x71, 0x5f, 0x81, 0x39, 0x63,
-	0xc4, 0xc2, 0x94, 0xe7, 0x95, 0x82, 0x52, 0x9e, 0x87, 0x01, 0xc4, 0xa6, 0x3c, 0xfb, 0xf7, 0x8a,
-	0x50, 0x11, 0x15, 0x80, 0x13, 0xa7, 0xf4, 0xa4, 0x13, 0x59, 0x98, 0xe8, 0x44, 0x86, 0x50, 0x76,
-	0x59, 0x3d, 0x51, 0xdc, 0x17, 0xd3, 0x84, 0xa5, 0x62, 0x74, 0xbc, 0x3e, 0xa9, 0xc7, 0xc4, 0x9f,
-	0xb1, 0x90, 0x83, 0xde, 0xb7, 0xe0, 0xb4, 0x4b, 0xc3, 0x12, 0x57, 0x9b, 0xb4, 0xd2, 0xd4, 0x09,
-	0xe7, 0xf5, 0x34, 0xc7, 0xc6, 0xc7, 0x84, 0xf4, 0xd3, 0x19, 0x04, 0xce, 0xca, 0x46, 0x5f, 0x80,
-	0x05, 0xae, 0xad, 0xb7, 0x48, 0xc4, 0x52, 0x70, 0x33, 0x4c, 0x59, 0x6a, 0xeb, 0x35, 0x4d, 0x24,
-	0x4e, 0xd3, 0xda, 0x7f, 0x55, 0x84, 0x85, 0xd4, 0xb4, 0xd1, 0x6b, 0x50, 0x1d, 0xc4, 0xf4, 0x20,
-	0x2b, 0xdf, 0x5d, 0x25, 0x34, 0x6f, 0x09, 0x38, 0x56, 0x14, 0x94, 0x3a, 0x74, 0xe2, 0xf8, 0x5e,
-	0x10, 0xb5, 0xc4, 0x22, 0x29, 0xea, 0x3d, 0x01, 0xc7, 0x8a, 0x82, 0x46, 0x95, 0x77, 0x88, 0x13,
-	0x91, 0x68, 0x3f, 0x38, 0x20, 0x23, 0x15, 0xb0, 0x86, 0x46, 0x61, 0x93, 0x8e, 0x69, 0x3c, 0xe9,
-	0xc5, 0xeb, 0x3d, 0x8f, 0xf8, 0x09, 0x1f, 0x66, 0x0e, 0x1a, 0xdf, 0xdf, 0x6e, 0x9a, 0x1c, 0xb5,
-	0xc6, 0x33, 0x08, 0x9c, 0x95, 0x8d, 0xbe, 0x66, 0xc1, 0x82, 0x73, 0x2f, 0xd6, 0xb5, 0x6c, 0xa6,
-	0xf2, 0xe9, 0xf6, 0x5e, 0xaa, 0x36, 0xde, 0x58, 0xa2, 0x0b, 0x97, 0x02, 0xe1, 0xb4, 0x44, 0xfb,
-	0x43, 0x0b, 0x64, 0x8d, 0xfc, 0x39, 0xe4, 0xad, 0x3b, 0xe9, 0xbc, 0x75, 0x63, 0xfa, 0x43, 0x36,
-	0x21, 0x67, 0xbd, 0x0b, 0x15, 0x1a, 0x92, 0x3a, 0x7e, 0x0b, 0x7d, 0x12, 0x2a, 0x2e, 0xff, 0x29,
-	0xee, 0x1c, 0x96, 0xd1, 0x14, 0x58, 0x2c, 0x71, 0xe8, 0x65, 0x28, 0x39, 0x51, 0x47, 0xde, 0x33,
-	0x2c, 0xe1, 0xbb, 0x16, 0x75, 0x62, 0xcc, 0xa0, 0xf6, 0xfb, 0x05, 0x80, 0xf5, 0xa0, 0x1f, 0x3a,
-	0x11, 0x69, 0xed, 0x07, 0xff, 0xe7, 0xc3, 0x3f, 0xfb, 0xb7, 0x2d, 0x40, 0x54, 0x1f, 0x81, 0x4f,
-	0x7c, 0x9d, 0x56, 0x41, 0xab, 0x30, 0xeb, 0x4a, 0xa8, 0x38, 0xf5, 0x2a, 0x1e, 0x50, 0xe4, 0x58,
-	0xd3, 0x9c, 0xc0, 0x30, 0x5f, 0x90, 0x59, 0x83, 0x62, 0x3a, 0xd9, 0xca, 0xb2, 0x6f, 0x22, 0x89,
-	0x60, 0xff, 0x4e, 0x01, 0x5e, 0xe2, 0x1b, 0x7a, 0xc7, 0xf1, 0x9d, 0x0e, 0xe9, 0xd3, 0x51, 0x9d,
-	0x34, 0x7f, 0xf0, 0x0e, 0x0d, 0xc4, 0x3c, 0x99, 0x5c, 0x9d, 0x6a, 0x4f, 0xf2, 0xbd, 0xc4, 0x77,
-	0xcf, 0x96, 0xef, 0x25, 0x98, 0x71, 0x46, 0x21, 0x54, 0x65, 0x1b, 0x8b, 0xb8, 0x5e, 0xf2, 0x90,
-	0xa2, 0x0e, 0xda, 0x55, 0xc1, 0x1b, 0x2b, 0x29, 0xf6, 0xf7, 0x2c, 0xc8, 0x5a, 0x7c, 0x76, 0x59,
-	0xf2, 0x3a, 0x63, 0xf6, 0xb2, 0x4c, 0x57, 0x06, 0x4f, 0x5e, 0x6c, 0x43, 0x5f, 0x82, 0x39, 0x27,
-	0x49, 0x48, 0x3f, 0x4c, 0x98, 0x3b, 0x5c, 0x7c, 0x3a, 0x77, 0x78, 0x27, 0x68, 0x79, 0x6d, 0x8f,
-	0xb9, 0xc3, 0x26, 0x3b, 0xfb, 0x4d, 0xa8, 0xca, 0x94, 0xcc, 0x09, 0x96, 0xf1, 0x42, 0x2a, 0xbd,
-	0x34, 0x61, 0xa3, 0x38, 0x30, 0x6f, 0x46, 0x73, 0xcf, 0x40, 0x27, 0xf6, 0xfb, 0x16, 0x2c, 0xa4,
-	0x12, 0xd3, 0x39, 0x8d, 0x9d, 0xde, 0x7a, 0xed, 0x80, 0x05, 0xda, 0x91, 0xe7, 0x73, 0x3f, 0xa5,
-	0xaa, 0x8f, 0xea, 0x15, 0x8d, 0xc2, 0x26, 0x9d, 0xbd, 0x03, 0x2c, 0x25, 0x90, 0x97, 0x06, 0xdf,
-	0x84, 0x2a, 0x65, 0x47, 0xad, 0x6d, 0x5e, 0x2c, 0x9b, 0x50, 0xbd, 0x7e, 0x7b, 0x9f, 0xdf, 0xd1,
-	0x36, 0x14, 0x3d, 0x87, 0xdb, 0x8e, 0xa2, 0xde, 0xe1, 0x5b, 0x71, 0x3c, 0x60, 0xfb, 0x83, 0x22,
-	0xd1, 0x05, 0x28, 0x92, 0xfb, 0x21, 0x63, 0x59, 0xd4, 0xf6, 0x65, 0xf3, 0x7e, 0xe8, 0x45, 0x24,
-	0xa6, 0x44, 0xe4, 0x7e, 0x68, 0x0f, 0x00, 0x74, 0xe2, 0x3a, 0xaf, 0x25, 0x38, 0x0f, 0x25, 0x37,
-	0x68, 0x11, 0xa1, 0x7b, 0xc5, 0x66, 0x3d, 0x68, 0x11, 0xcc, 0x30, 0xf6, 0xb7, 0x2c, 0x38, 0x93,
-	0xcd, 0x36, 0xff, 0xc4, 0xcc, 0xe2, 0x36, 0x9c, 0x51, 0xb9, 0xdd, 0x9b, 0x21, 0x0f, 0xd5, 0x2f,
-	0xc3, 0xfc, 0x9d, 0x81, 0xd7, 0x6b, 0x89, 0x67, 0x31, 0x1c, 0x95, 0xe6, 0x6d, 0x18, 0x38, 0x9c,
-	0xa2, 0xb4, 0x63, 0xd0, 0x65, 0x7d, 0xd4, 0x16, 0x89, 0x1c, 0x6b, 0x6a, 0x8f, 0xa5, 0x79, 0xe8,
-	0xbb, 0xba, 0x7b, 0xa0, 0x9a, 0xce, 0xe3, 0xd8, 0x7f, 0x52, 0x82, 0x4c, 0x48, 0x8e, 0x06, 0x66,
-	0xe7, 0x82, 0x95, 0x63, 0xe7, 0x82, 0x5a, 0x93, 0x71, 0xdd, 0x0b, 0xe8, 0x73, 0x30, 0x13, 0x76,
-	0x9d, 0x58, 0x2e, 0xca, 0x8a, 0xd4, 0xf8, 0x1e, 0x05, 0x3e, 0x32, 0x33, 0x07, 0x0c, 0x82, 0x39,
-	0xb5, 0x69, 0x39, 0x8a, 0xc7, 0x58, 0xd3, 0xaf, 0xf2, 0x44, 0x29, 0x26, 0xf1, 0xa0, 0x97, 0x08,
-	0xcf, 0x74, 0x37, 0x2f, 0xcd, 0x72, 0xae, 0x3a, 0x63, 0xca, 0x9f, 0xb1, 0x21, 0x11, 0x7d, 0x11,
-	0x66, 0xe3, 0xc4, 0x89, 0x92, 0xa7, 0x4c, 0xe1, 0x28, 0xf5, 0x35, 0x25, 0x13, 0xac, 0xf9, 0xa1,
-	0xb7, 0x01, 0xda, 0x9e, 0xef, 0xc5, 0x5d, 0xc6, 0xbd, 0xf2, 0x74, 0x37, 0xc5, 0x15, 0xc5, 0x01,
-	0x1b, 0xdc, 0xec, 0x5f, 0x80, 0xf3, 0xc7, 0xb5, 0x3c, 0x51, 0xff, 0xee, 0x9e, 0x13, 0xf9, 0xa2,
-	0xda, 0xca, 0xb6, 0xd9, 0x6d, 0x27, 0xf2, 0x31, 0x83, 0xda, 0x7f, 0x6d, 0x01, 0x1a, 0x6d, 0x4c,
-	0xa2, 0x8b, 0x47, 0x7c, 0xe7, 0x4e, 0x8f, 0xb4, 0xb2, 0x55, 0xda, 0x4d, 0x0e, 0xc6, 0x12, 0x8f,
-	0x1e, 0x40, 0xe5, 0x9e, 0xe7, 0xb7, 0x82, 0x7b, 0xd2, 0xb9, 0x6d, 0xe6, 0xda, 0x23, 0x75, 0x9b,
-	0xf1, 0xe6, 0xbe, 0x2b, 0xff, 0x1d, 0x63, 0x29, 0xd0, 0xfe, 0x46, 0x01, 0x6a, 0x93, 0x5e, 0xa1,
-	0xa1, 0x55, 0xec, 0x76, 0x49, 0x6b, 0xd0, 0x1b, 0x09, 0xc4, 0x9a, 0x02, 0x8e, 0x15, 0x05, 0xa5,
-	0x6e, 0x0d, 0x22, 0xed, 0x5f, 0x1a, 0xd4, 0x1b, 0x02, 0x8e, 0x15, 0x05, 0xba, 0x04, 0xf3, 0xc6,
-	0xf8, 0x65, 0x65, 0x8b, 0x25, 0x75, 0x0c, 0xd7, 0x32, 0xc6, 0x29, 0x2a, 0x54, 0xe7, 0xd5, 0x33,
-	0xd6, 0x3c, 0xc3, 0x0b, 0x5a, 0xa2, 0x6c, 0xac, 0xba, 0x6b, 0x62, 0x6c, 0x50, 0xa0, 0x57, 0xa0,
-	0x2a, 0x1a, 0xfb, 0x78, 0x82, 0x73, 0xb6, 0x31, 0x4f, 0xc7, 0x23, 0x22, 0x80, 0x18, 0x2b, 0xac,
-	0xfd, 0x9d, 0x02, 0xcc, 0x19, 0xcd, 0x89, 0x27, 0x30, 0xfb, 0x99, 0x66, 0xca, 0xc2, 0x09, 0x9b,
-	0x29, 0x5f, 0x81, 0x6a, 0x18, 0xf4, 0x3c, 0xd7, 0x53, 0xe5, 0x3c, 0x36, 0xa4, 0x3d, 0x01, 0xc3,
-	0x0a, 0x8b, 0x12, 0x98, 0xbd, 0x7b, 0x2f, 0x61, 0x97, 0x9b, 0x2c, 0xde, 0x4d, 0x53, 0xa3, 0x92,
-	0x17, 0xa5, 0x3e, 0x6d, 0x12, 0x12, 0x63, 0x2d, 0x08, 0xd9, 0x50, 0xee, 0x44, 0xc1, 0x20, 0x94,
-	0x0a, 0x63, 0x79, 0x33, 0xd6, 0xb8, 0x18, 0x63, 0x81, 0xb1, 0x8f, 0x66, 0x00, 0x58, 0x7f, 0xab,
-	0xc7, 0x32, 0xc9, 0xe7, 0xa1, 0x14, 0x91, 0x30, 0xc8, 0xea, 0x8a, 0x52, 0x60, 0x86, 0x49, 0x85,
-	0xf4, 0x85, 0x27, 0x0a, 0xe9, 0x8b, 0xc7, 0x86, 0xf4, 0x5f, 0x80, 0x85, 0x38, 0xee, 0xee, 0x45,
-	0xde, 0xd0, 0x49, 0xc8, 0x0d, 0x72, 0x28, 0x8a, 0xed, 0x3a, 0xfb, 0xd0, 0xbc, 0xa6, 0x91, 0x38,
-	0x4d, 0x3b, 0x36, 0x95, 0x32, 0xf3, 0x13, 0x4c, 0xa5, 0x34, 0xe1, 0xac, 0xe7, 0xc7, 0xc4, 0x1d,
-	0x44, 0xa2, 0x4a, 0x74, 0x2d, 0x88, 0x13, 0x3a, 0xa9, 0x32, 0x33, 0x22, 0x9f, 0x10, 0x8c, 0xce,
-	0x6e, 0x8d, 0x23, 0xc2, 0xe3, 0xdf, 0xa5, 0xfa, 0x94, 0x08, 0x66, 0x3e, 0xab, 0x86, 0x7b, 0x24,
-	0xe0, 0x58, 0x51, 0x50, 0x97, 0x83, 0x5b, 0xa6, 0xed, 0x76, 0xcc, 0xd2, 0xd4, 0x55, 0xc3, 0x53,
-	0xe2, 0x88, 0x2b, 0x4d, 0xac, 0x69, 0xd0, 0x55, 0x58, 0xd2, 0xf9, 0x09, 0x12, 0x25, 0x1b, 0x4e,
-	0xe2, 0x88, 0x1c, 0xb4, 0xaa, 0x6b, 0xe9, 0x8c, 0x86, 0x20, 0xc0, 0xa3, 0xef, 0xa0, 0x0d, 0x38,
-	0x93, 0x02, 0xd2, 0x79, 0x03, 0xe3, 0x53, 0x13, 0x7c, 0xce, 0xa4, 0xf8, 0xd0, 0x29, 0x8f, 0xbc,
-	0xa1, 0x7a, 0x02, 0xe7, 0x26, 0xf6, 0x04, 0xca, 0xb3, 0x3d, 0x3f, 0xe9, 0x6c, 0xdb, 0xdf, 0x2c,
-	0xc0, 0x59, 0xbd, 0xc1, 0x29, 0x67, 0xaf, 0x4d, 0x57, 0x99, 0xd5, 0xef, 0x79, 0xfe, 0xca, 0xf8,
-	0x64, 0x40, 0xd5, 0x38, 0x9a, 0x0a, 0x83, 0x0d, 0x2a, 0xaa, 0x7f, 0x97, 0x44, 0x2c, 0x11, 0x9a,
-	0xdd, 0xfd, 0xeb, 0x02, 0x8e, 0x15, 0x05, 0xfb, 0x2a, 0x81, 0x44, 0x49, 0x73, 0x70, 0x87, 0xbd,
-	0x90, 0x49, 0x51, 0xad, 0x6b, 0x14, 0x36, 0xe9, 0x98, 0xa9, 0x93, 0xca, 0xa7, 0x27, 0x60, 0x5e,
-	0x98, 0x3a, 0xa9, 0x6f, 0x85, 0x95, 0xc3, 0xa1, 0xbe, 0xb8, 0xc8, 0xd4, 0xa5, 0x86, 0xc3, 0xaa,
-	0x80, 0x8a, 0xc2, 0xfe, 0x4f, 0x0b, 0x3e, 0x3e, 0x56, 0x15, 0xcf, 0x21, 0xe9, 0x33, 0x48, 0x27,
-	0x7d, 0xf6, 0xa6, 0x4a, 0x8a, 0x8f, 0x99, 0xc2, 0x84, 0x14, 0xd0, 0x3f, 0x5a, 0xb0, 0xa8, 0xe9,
-	0x9f, 0xc3, 0x3c, 0xdb, 0xf9, 0x7d, 0xd7, 0xa0, 0xc7, 0xdd, 0x98, 0x1d, 0x99, 0xd8, 0x0f, 0xd9,
-	0xc4, 0xb8, 0x9b, 0xb3, 0xe6, 0xca, 0x0e, 0xda, 0x63, 0xee, 0xb9, 0x21, 0x94, 0x59, 0x7b, 0x8b,
-	0x1c, 0xdd, 0x6e, 0x0e, 0xa5, 0x09, 0x2e, 0x9c, 0x85, 0x39, 0x3a, 0x70, 0x66, 0x8f, 0x31, 0x16,
-	0xd2, 0xa8, 0x1d, 0x72, 0x86, 0x8e, 0xd7, 0xa3, 0x66, 0x46, 0x84, 0x4d, 0xca, 0x0e, 0xad, 0x49,
-	0x04, 0xd6, 0x34, 0x76, 0x1f, 0x6a, 0x69, 0xfe, 0x1b, 0x84, 0x7a, 0x7a, 0x27, 0x9c, 0x26, 0x15,
-	0xc7, 0xde, 0xda, 0x1e, 0x38, 0xd9, 0xde, 0xdd, 0x35, 0x89, 0xc0, 0x9a, 0xc6, 0xfe, 0x33, 0x0b,
-	0x5e, 0x18, 0x33, 0x9f, 0x1c, 0x03, 0xc6, 0x44, 0x9f, 0xff, 0x09, 0xad, 0xcd, 0x2d, 0xd2, 0x76,
-	0xa4, 0xc7, 0x6f, 0xc4, 0x07, 0x1b, 0x1c, 0x8c, 0x25, 0xde, 0xfe, 0x77, 0x0b, 0x4e, 0xa7, 0xc7,
-	0x1a, 0xa3, 0xeb, 0x80, 0xf8, 0x64, 0x36, 0xbc, 0xd8, 0x0d, 0x86, 0x24, 0x3a, 0xa4, 0x33, 0xe7,
-	0xa3, 0x5e, 0x16, 0x9c, 0xd0, 0xda, 0x08, 0x05, 0x1e, 0xf3, 0x16, 0xfa, 0x16, 0xcb, 0x2f, 0x4a,
-	0x6d, 0xe7, 0xe1, 0xc7, 0x4e, 0x5a, 0x49, 0xd3, 0xc3, 0x52, 0xf2, 0xb0, 0x29, 0xdc, 0xfe, 0xb0,
-	0x00, 0xf3, 0xf2, 0xf5, 0x0d, 0xaf, 0xdd, 0xa6, 0xfa, 0x66, 0x8e, 0x8b, 0x98, 0x9c, 0xd2, 0x37,
-	0xf3, 0x6a, 0x30, 0xc7, 0x51, 0x7d, 0x1f, 0x78, 0x7e, 0x2b, 0x1b, 0x38, 0xdf, 0xf0, 0xfc, 0x16,
-	0x66, 0x98, 0x74, 0x77, 0x77, 0xf1, 0xf8, 0xee, 0x6e, 0xb5, 0x13, 0x4a, 0x8f, 0xf3, 0x21, 0x79,
-	0x3f, 0xb2, 0xf6, 0x3c, 0x0c, 0x5b, 0xbf, 0xaf, 0x51, 0xd8, 0xa4, 0xa3, 0x23, 0xe9, 0x79, 0x43,
-	0xc2, 0x5f, 0x2a, 0xa7, 0x47, 0xb2, 0x2d, 0x11, 0x58, 0xd3, 0xd0, 0x91, 0xb4, 0xbc, 0x76, 0x9b,
-	0xdd, 0xfe, 0xc6, 0x48, 0xa8, 0x76, 0x30, 0xc3, 0x50, 0x8a, 0x6e, 0x10, 0x1c, 0x88, 0x0b, 0x5f,
-	0x51, 0x5c, 0x0b, 0x82, 0x03, 0xcc, 0x30, 0xf6, 0x8f, 0xd9, 0x45, 0x30, 0xa1, 0x83, 0x25, 0x2f,
-	0x1d, 0x4b, 0x95, 0x15, 0x1f, 0x77, 0x4e, 0xf5, 0x2a, 0x94, 0x4e, 0xb0, 0x0a, 0x97, 0x60, 0xfe,
-	0x6e, 0x1c, 0xf8, 0x7b, 0x01, 0x0d, 0x6f, 0x54, 0x1c, 0xc0, 0x22, 0x8d, 0xeb, 0xcd, 0x9b, 0xbb,
-	0x12, 0x8e, 0x53, 0x54, 0xf6, 0xf7, 0x66, 0xe0, 0x25, 0x55, 0x48, 0x25, 0xc9, 0xbd, 0x20, 0x3a,
-	0xf0, 0xfc, 0x0e, 0x4b, 0x87, 0x7d, 0xdb, 0x82, 0x79, 0xbe, 0x1a, 0xa2, 0xb1, 0x8e, 0x57, 0x8a,
-	0xdd, 0x3c, 0x4a, 0xb6, 0x29, 0x49, 0xf5, 0x7d, 0x43, 0x4a, 0xa6, 0xa9, 0xce, 0x44, 0xe1, 0xd4,
-	0x70, 0xd0, 0x03, 0x00, 0xd9, 0xe4, 0xde, 0xce, 0xa3, 0xcf, 0x5f, 0x0e, 0x0e, 0x93, 0xb6, 0x76,
-	0x75, 0xf6, 0x95, 0x04, 0x6c, 0x48, 0x43, 0x5f, 0xb7, 0xa0, 0xdc, 0xe3, 0x5a, 0x29, 0x32, 0xc1,
-	0xbf, 0x98, 0xbf, 0x56, 0x4c, 0x7d, 0xa8, 0xcb, 0x43, 0x68, 0x42, 0x08, 0x47, 0x18, 0x2a, 0x9e,
-	0xdf, 0x89, 0x48, 0x2c, 0x23, 0xa7, 0x4f, 0x1b, 0xd7, 0x75, 0xdd, 0x0d, 0x22, 0xc2, 0x2e, 0xe7,
-	0xc0, 0x69, 0x35, 0x9c, 0x1e, 0x0d, 0x79, 0xa3, 0x2d, 0x4e, 0xae, 0x8d, 0xa8, 0x00, 0x60, 0xc9,
-	0x68, 0xa4, 0x0f, 0x61, 0xe6, 0x24, 0x7d, 0x08, 0xcb, 0x6f, 0xc0, 0xd2, 0xc8, 0x32, 0x3e, 0x49,
-	0x8b, 0xe3, 0xf2, 0xe7, 0x61, 0xee, 0x69, 0xbb, 0x23, 0x3f, 0x9c, 0xd1, 0x96, 0x70, 0x37, 0x68,
-	0xb1, 0x02, 0x7c, 0xa4, 0x57, 0x53, 0x78, 0x32, 0x79, 0xed, 0x0d, 0xa3, 0x21, 0x5a, 0x01, 0xb1,
-	0x29, 0x8f, 0xee, 0xcc, 0xd0, 0x89, 0x88, 0xff, 0x4c, 0x77, 0xe6, 0x9e, 0x92, 0x80, 0x0d, 0x69,
-	0x88, 0x88, 0xa6, 0xb9, 0xe2, 0xd4, 0x81, 0xb4, 0x4c, 0x62, 0x8f, 0x6b, 0x9c, 0xa3, 0x01, 0xe5,
-	0xa2, 0x9f, 0xda, 0xaf, 0x22, 0x1d, 0xf7, 0x66, 0xee, 0x07, 0x81, 0x77, 0x1d, 0xa5, 0x61, 0x38,
-	0x23, 0x1c, 0xad, 0xc1, 0x69, 0xb9, 0x02, 0xe9, 0xea, 0xbc, 0x8a, 0x49, 0x71, 0x1a, 0x8d, 0xb3,
-	0xf4, 0x46, 0x27, 0x4d, 0x79, 0x52, 0x27, 0x0d, 0x3a, 0x50, 0x4d, 0x73, 0x95, 0x7c, 0x9b, 0xe6,
-	0x60, 0xb4, 0x61, 0xce, 0xfe, 0xae, 0x05, 0x67, 0xe4, 0xa8, 0x6f, 0x0e, 0x49, 0x14, 0x79, 0x2d,
-	0x76, 0x2f, 0x70, 0xb4, 0xf6, 0x62, 0xd4, 0xbd, 0x70, 0x4d, 0x22, 0xb0, 0xa6, 0xa1, 0x61, 0xeb,
-	0x68, 0x93, 0x67, 0x21, 0x1d, 0xb6, 0x9e, 0xa8, 0x1d, 0xf3, 0x55, 0xa8, 0x70, 0x97, 0x28, 0xce,
-	0xe6, 0x69, 0x85, 0xab, 0x85, 0x25, 0xde, 0xfe, 0x2f, 0x0b, 0xcc, 0xd3, 0x71, 0xb2, 0x5b, 0xf3,
-	0x55, 0xa8, 0x0c, 0xc5, 0xd2, 0x65, 0x2a, 0x48, 0x72, 0xc9, 0x24, 0x5e, 0x5d, 0xb0, 0xc5, 0x93,
-	0x39, 0x31, 0xa5, 0x27, 0x70, 0x62, 0x66, 0x26, 0xde, 0xc8, 0x9f, 0x80, 0xe2, 0xc0, 0x6b, 0x09,
-	0x3f, 0x64, 0x4e, 0x10, 0x14, 0x6f, 0x6d, 0x6d, 0x60, 0x0a, 0xb7, 0xff, 0xb5, 0xa8, 0x83, 0x0e,
-	0x91, 0x2e, 0xfe, 0xa9, 0x98, 0xf6, 0x25, 0x55, 0x00, 0xe4, 0x33, 0x7f, 0x39, 0x5d, 0x00, 0x7c,
-	0x74, 0xb4, 0x02, 0x7c, 0xba, 0xac, 0xc6, 0x33, 0xa6, 0x1c, 0x58, 0x39, 0x26, 0xa9, 0x7f, 0x19,
-	0xaa, 0xd4, 0xf1, 0x62, 0x59, 0x80, 0x6a, 0x4a, 0x44, 0xf5, 0x9a, 0x80, 0x3f, 0x32, 0x7e, 0x63,
-	0x45, 0x8d, 0xd6, 0x60, 0x96, 0xfe, 0x66, 0xd5, 0x04, 0x91, 0x89, 0xb9, 0xa0, 0xce, 0x82, 0x44,
-	0x8c, 0x29, 0x3c, 0xe8, 0xb7, 0xa8, 0xc2, 0x58, 0x47, 0x34, 0x63, 0x01, 0x69, 0x85, 0x35, 0x25,
-	0x02, 0x6b, 0x1a, 0xfb, 0x23, 0x63, 0x99, 0x45, 0x89, 0xf4, 0xa7, 0x62, 0x99, 0x2f, 0x67, 0x96,
-	0xf9, 0xfc, 0xc8, 0x32, 0x2f, 0xea, 0x86, 0xe2, 0xd4, 0x52, 0x3f, 0x4f, 0x9b, 0x78, 0xbc, 0xff,
-	0xce, 0x6f, 0x82, 0x77, 0x07, 0x5e, 0x44, 0xe2, 0xbd, 0x68, 0xe0, 0x7b, 0x7e, 0x87, 0x6d, 0x8d,
-	0xaa, 0x79, 0x13, 0xa4, 0xd0, 0x38, 0x4b, 0x6f, 0xff, 0x45, 0x81, 0x86, 0x91, 0xa9, 0x06, 0x63,
-	0xf4, 0x1a, 0x54, 0x23, 0xf9, 0xe9, 0x65, 0x26, 0xb9, 0xa5, 0x3e, 0xba, 0x54, 0x14, 0xe8, 0xcb,
-	0x00, 0x2d, 0x12, 0xf6, 0x82, 0x43, 0x56, 0xcb, 0x29, 0x3d, 0x71, 0x2d, 0x47, 0xdd, 0xf2, 0x1b,
-	0x8a, 0x0b, 0x36, 0x38, 0xa2, 0x65, 0x28, 0x78, 0x2d, 0xb6, 0x9a, 0xc5, 0x06, 0x08, 0xda, 0xc2,
-	0xd6, 0x06, 0x2e, 0x78, 0x2d, 0xa3, 0xf5, 0xa6, 0xfc, 0xfc, 0x5a, 0x6f, 0xec, 0xbf, 0x67, 0x97,
-	0x15, 0x9f, 0xfe, 0x8e, 0x4c, 0xf8, 0x7c, 0x0a, 0xca, 0xce, 0x20, 0xe9, 0x06, 0x23, 0xdd, 0x87,
-	0x6b, 0x0c, 0x8a, 0x05, 0x16, 0x6d, 0x43, 0xa9, 0x45, 0x63, 0xbc, 0xc2, 0x13, 0x2b, 0x4a, 0xc7,
-	0x78, 0x34, 0x14, 0x64, 0x5c, 0xd0, 0xcb, 0x50, 0x4a, 0x9c, 0x8e, 0x2c, 0x3b, 0xb0, 0x42, 0xd6,
-	0xbe, 0xd3, 0x89, 0x31, 0x83, 0x9a, 0x96, 0xa9, 0x74, 0x4c, 0xa3, 0xc2, 0x9f, 0x97, 0x60, 0x21,
-	0x55, 0x22, 0x4c, 0xed, 0x02, 0xeb, 0xd8, 0x5d, 0x70, 0x01, 0x66, 0xc2, 0x68, 0xe0, 0xf3, 0x79,
-	0x55, 0xb5, 0x61, 0xa0, 0xfb, 0x8c, 0x60, 0x8e, 0xa3, 0x3a, 0x6a, 0x45, 0x87, 0x78, 0xe0, 0x8b,
-	0xe4, 0x8f, 0xd2, 0xd1, 0x06, 0x83, 0x62, 0x81, 0x45, 0x5f, 0x81, 0xf9, 0x98, 0x1d, 0xc0, 0xc8,
-	0x49, 0x48, 0x47, 0x7e, 0x26, 0x72, 0x75, 0xea, 0x0f, 0x04, 0x38, 0x3b, 0xee, 0xdf, 0x9b, 0x10,
-	0x9c, 0x12, 0x87, 0xbe, 0x66, 0x99, 0x1f, 0x45, 0x94, 0xa7, 0x4e, 0x54, 0x66, 0x4b, 0xaf, 0x7c,
-	0x77, 0x3d, 0xfe, 0xdb, 0x88, 0x50, 0xed, 0xec, 0xca, 0x33, 0xd8, 0xd9, 0x30, 0xa6, 0xa1, 0xec,
-	0x33, 0x30, 0xdb, 0x77, 0x7c, 0xaf, 0x4d, 0xe2, 0x24, 0xae, 0x55, 0xd9, 0x7e, 0x62, 0x5f, 0xdb,
-	0xee, 0x48, 0x20, 0xd6, 0x78, 0xfb, 0x3d, 0x0b, 0xce, 0x8e, 0x9d, 0xd6, 0x73, 0xcb, 0x1a, 0x50,
-	0xcb, 0xf5, 0xc2, 0x98, 0xa2, 0x36, 0x1a, 0x3e, 0x9b, 0x2f, 0x5a, 0x44, 0xc9, 0x7c, 0x61, 0xe2,
-	0x8a, 0x3d, 0x99, 0xd5, 0xd4, 0x96, 0xab, 0xf8, 0x1c, 0x2d, 0xd7, 0x6f, 0x5a, 0x60, 0x7c, 0x21,
-	0x85, 0x7e, 0x19, 0x66, 0x9d, 0x41, 0x12, 0xf4, 0x9d, 0x44, 0xd4, 0xb4, 0xa7, 0x6f, 0x31, 0xe0,
-	0x9c, 0xd7, 0x24, 0x57, 0xae, 0x2f, 0xf5, 0x88, 0xb5, 0x3c, 0xbb, 0xcb, 0x97, 0x2f, 0xf3, 0x82,
-	0x36, 0x24, 0xd6, 0x63, 0x0c, 0xc9, 0x6b, 0x50, 0x8d, 0x49, 0xaf, 0x4d, 0x2f, 0x4c, 0x61, 0x70,
-	0x74, 0x19, 0x5b, 0xc0, 0xb1, 0xa2, 0xb0, 0xff, 0x43, 0xcc, 0x5a, 0xf8, 0x30, 0x97, 0x33, 0x6d,
-	0x5e, 0x27, 0xbf, 0xfe, 0x0f, 0x01, 0x5c, 0xd5, 0xf7, 0x99, 0xc3, 0x67, 0x4b, 0xba, 0x89, 0xd4,
-	0xfc, 0xa8, 0x46, 0xc2, 0xb0, 0x21, 0x2c, 0xb5, 0xbb, 0x8a, 0xc7, 0xed, 0x2e, 0xfb, 0xdf, 0x2c,
-	0x48, 0x19, 0x38, 0xd4, 0x87, 0x19, 0x3a, 0x82, 0xc3, 0x1c, 0x5a, 0x54, 0x4d, 0xbe, 0x74, 0xe7,
-	0x89, 0xaa, 0x04, 0xfb, 0x89, 0xb9, 0x14, 0xe4, 0x09, 0xd7, 0x85, 0xab, 0xe8, 0x46, 0x4e, 0xd2,
-	0xa8, 0xe7, 0x23, 0xfe, 0x7d, 0x41, 0xe7, 0x30, 0x2f, 0xc3, 0xd2, 0xc8, 0x88, 0xe8, 0x26, 0x62,
-	0x5d, 0x6f, 0xd9, 0x4d, 0xc4, 0xfa, 0xe2, 0x30, 0xc7, 0xd9, 0xdf, 0xb1, 0xe0, 0x4c, 0x96, 0x3d,
-	0xfa, 0x03, 0x0b, 0x96, 0xe2, 0x2c, 0xbf, 0x67, 0xa2, 0x35, 0x15, 0x91, 0x8e, 0xa0, 0xf0, 0xe8,
-	0x08, 0xe8, 0x8a, 0x66, 0x7b, 0xc8, 0x53, 0x45, 0x60, 0xeb, 0xd8, 0x22, 0x70, 0xba, 0xcc, 0x59,
-	0x38, 0x51, 0x99, 0xd3, 0xac, 0x40, 0x16, 0x1f, 0x5b, 0x81, 0xfc, 0x24, 0x54, 0x0e, 0xc8, 0xa1,
-	0x51, 0xaa, 0xe4, 0x7f, 0x15, 0xc1, 0x41, 0x58, 0xe2, 0x90, 0x0d, 0x65, 0xd7, 0x61, 0x54, 0x33,
-	0x8c, 0x8a, 0x5d, 0x44, 0xeb, 0x6b, 0x8c, 0x48, 0x60, 0x1a, 0xf5, 0x0f, 0x3e, 0x3a, 0x77, 0xea,
-	0xfb, 0x1f, 0x9d, 0x3b, 0xf5, 0x83, 0x8f, 0xce, 0x9d, 0x7a, 0xef, 0xe1, 0x39, 0xeb, 0x83, 0x87,
-	0xe7, 0xac, 0xef, 0x3f, 0x3c, 0x67, 0xfd, 0xe0, 0xe1, 0x39, 0xeb, 0x5f, 0x1e, 0x9e, 0xb3, 0x7e,
-	0xf7, 0x47, 0xe7, 0x4e, 0xbd, 0x5d, 0x95, 0xaa, 0xfd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04,
-	0xad, 0xd3, 0xd2, 0x7d, 0x4f, 0x00, 0x00,
+	// 4709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x5b, 0x8c, 0x1c, 0xd9,
+	0x55, 0x5b, 0xfd, 0x98, 0xee, 0x3e, 0xf3, 0xb0, 0xe7, 0x6e, 0xbc, 0xe9, 0x8c, 0x36, 0x1e, 0xab,
+	0xac, 0x24, 0xbb, 0x84, 0xf4, 0xb0, 0x2b, 0x07, 0x1c, 0x90, 0x08, 0xd3, 0x33, 0x63, 0x7b, 0xec,
+	0x19, 0x7b, 0xf6, 0xf6, 0x78, 0x2d, 0x25, 0x21, 0x6c, 0xb9, 0xfa, 0x76, 0x77, 0x79, 0xba, 0xab,
+	0x6a, 0xab, 0xaa, 0xdb, 0x9e, 0x85, 0x84, 0xe5, 0xa9, 0x10, 0x58, 0x84, 0x40, 0x7c, 0xa1, 0x48,
+	0x80, 0xf8, 0x21, 0xe2, 0x07, 0x21, 0x25, 0x1f, 0x7c, 0x91, 0x0f, 0xd8, 0xcf, 0x80, 0x56, 0x28,
+	0x02, 0x34, 0x62, 0x1d, 0x3e, 0x10, 0xf9, 0x00, 0x84, 0xf8, 0xf1, 0x17, 0xba, 0xef, 0x5b, 0xd5,
+	0xdd, 0x9e, 0xb6, 0xbb, 0xec, 0x48, 0xe1, 0x6b, 0xba, 0xce, 0x39, 0x75, 0xce, 0xbd, 0xe7, 0xde,
+	0x7b, 0xee, 0x79, 0xd5, 0xc0, 0x6e, 0xd7, 0x4b, 0x7a, 0xc3, 0xbb, 0x0d, 0x37, 0x18, 0x6c, 0x38,
+	0x51, 0x37, 0x08, 0xa3, 0xe0, 0x1e, 0xfb, 0xf1, 0x19, 0xb7, 0xbd, 0x11, 0x1e, 0x75, 0x37, 0x9c,
+	0xd0, 0x8b, 0x37, 0x9c, 0x30, 0xec, 0x7b, 0xae, 0x93, 0x78, 0x81, 0xbf, 0x31, 0x7a, 0xcd, 0xe9,
+	0x87, 0x3d, 0xe7, 0xb5, 0x8d, 0x2e, 0xf1, 0x49, 0xe4, 0x24, 0xa4, 0xdd, 0x08, 0xa3, 0x20, 0x09,
+	0xd0, 0xe7, 0x34, 0xab, 0x86, 0x64, 0xc5, 0x7e, 0xfc, 0x82, 0xdb, 0x6e, 0x84, 0x47, 0xdd, 0x06,
+	0x65, 0xd5, 0x30, 0x58, 0x35, 0x24, 0xab, 0xb5, 0xcf, 0x18, 0xa3, 0xe8, 0x06, 0xdd, 0x60, 0x83,
+	0x71, 0xbc, 0x3b, 0xec, 0xb0, 0x27, 0xf6, 0xc0, 0x7e, 0x71, 0x49, 0x6b, 0xf6, 0xd1, 0xe5, 0xb8,
+	0xe1, 0x05, 0x74, 0x6c, 0x1b, 0x6e, 0x10, 0x91, 0x8d, 0xd1, 0xd8, 0x68, 0xd6, 0x2e, 0x69, 0x9a,
+	0x81, 0xe3, 0xf6, 0x3c, 0x9f, 0x44, 0xc7, 0x7a, 0x42, 0x03, 0x92, 0x38, 0x93, 0xde, 0xda, 0x98,
+	0xf6, 0x56, 0x34, 0xf4, 0x13, 0x6f, 0x40, 0xc6, 0x5e, 0xf8, 0xc9, 0xd3, 0x5e, 0x88, 0xdd, 0x1e,
+	0x19, 0x38, 0xd9, 0xf7, 0xec, 0xb7, 0x61, 0x79, 0xf3, 0x4e, 0x6b, 0x73, 0x98, 0xf4, 0xb6, 0x02,
+	0xbf, 0xe3, 0x75, 0xd1, 0x67, 0x61, 0xd1, 0xed, 0x0f, 0xe3, 0x84, 0x44, 0x37, 0x9d, 0x01, 0xa9,
+	0x5b, 0x17, 0xac, 0x57, 0x6a, 0xcd, 0x17, 0xdf, 0x3f, 0x59, 0x7f, 0xe1, 0xe1, 0xc9, 0xfa, 0xe2,
+	0x96, 0x46, 0x61, 0x93, 0x0e, 0xbd, 0x0a, 0x95, 0x28, 0xe8, 0x93, 0x4d, 0x7c, 0xb3, 0x5e, 0x60,
+	0xaf, 0x9c, 0x11, 0xaf, 0x54, 0x30, 0x07, 0x63, 0x89, 0xb7, 0xff, 0xd9, 0x02, 0xd8, 0x0c, 0xc3,
+	0x83, 0x28, 0xb8, 0x47, 0xdc, 0x04, 0xbd, 0x05, 0x55, 0xaa, 0x85, 0xb6, 0x93, 0x38, 0x4c, 0xda,
+	0xe2, 0xeb, 0x3f, 0xd1, 0xe0, 0x93, 0x69, 0x98, 0x93, 0xd1, 0x2b, 0x47, 0xa9, 0x1b, 0xa3, 0xd7,
+	0x1a, 0xb7, 0xee, 0xd2, 0xf7, 0xf7, 0x49, 0xe2, 0x34, 0x91, 0x10, 0x06, 0x1a, 0x86, 0x15, 0x57,
+	0x74, 0x04, 0xa5, 0x38, 0x24, 0x2e, 0x1b, 0xd8, 0xe2, 0xeb, 0xbb, 0x8d, 0xa7, 0xde, 0x1f, 0x0d,
+	0x3d, 0xec, 0x56, 0x48, 0xdc, 0xe6, 0x92, 0x10, 0x5b, 0xa2, 0x4f, 0x98, 0x09, 0xb1, 0xff, 0xc9,
+	0x82, 0x15, 0x4d, 0xb6, 0xe7, 0xc5, 0x09, 0xfa, 0xd2, 0xd8, 0x0c, 0x1b, 0xb3, 0xcd, 0x90, 0xbe,
+	0xcd, 0xe6, 0x77, 0x56, 0x08, 0xaa, 0x4a, 0x88, 0x31, 0xbb, 0x7b, 0x50, 0xf6, 0x12, 0x32, 0x88,
+	0xeb, 0x85, 0x0b, 0xc5, 0x57, 0x16, 0x5f, 0xdf, 0xc9, 0x65, 0x7a, 0xcd, 0x65, 0x21, 0xb1, 0xbc,
+	0x4b, 0x79, 0x63, 0x2e, 0xc2, 0xfe, 0x9b, 0x8a, 0x39, 0x39, 0x3a, 0x6b, 0xf4, 0x1a, 0x2c, 0xc6,
+	0xc1, 0x30, 0x72, 0x09, 0x26, 0x61, 0x10, 0xd7, 0xad, 0x0b, 0x45, 0xba, 0xf8, 0x74, 0xaf, 0xb4,
+	0x34, 0x18, 0x9b, 0x34, 0xe8, 0xb7, 0x2d, 0x58, 0x6a, 0x93, 0x38, 0xf1, 0x7c, 0x26, 0x5f, 0x8e,
+	0xfc, 0x8d, 0xf9, 0x46, 0x2e, 0x81, 0xdb, 0x9a, 0x73, 0xf3, 0x23, 0x62, 0x16, 0x4b, 0x06, 0x30,
+	0xc6, 0x29, 0xe1, 0x74, 0xc3, 0xb7, 0x49, 0xec, 0x46, 0x5e, 0x48, 0x9f, 0xeb, 0xc5, 0xf4, 0x86,
+	0xdf, 0xd6, 0x28, 0x6c, 0xd2, 0xa1, 0x23, 0x28, 0xd3, 0x0d, 0x1d, 0xd7, 0x4b, 0x6c, 0xf0, 0x57,
+	0xe6, 0x18, 0xbc, 0x50, 0x27, 0x3d, 0x28, 0x5a, 0xef, 0xf4, 0x29, 0xc6, 0x5c, 0x06, 0x7a, 0xcf,
+	0x82, 0xba, 0x38, 0x6d, 0x98, 0x70, 0x55, 0xde, 0xe9, 0x79, 0x09, 0xe9, 0x7b, 0x71, 0x52, 0x2f,
+	0xb3, 0x01, 0x6c, 0xcc, 0xb6, 0xa5, 0xae, 0x46, 0xc1, 0x30, 0xbc, 0xe1, 0xf9, 0xed, 0xe6, 0x05,
+	0x21, 0xa9, 0xbe, 0x35, 0x85, 0x31, 0x9e, 0x2a, 0x12, 0xfd, 0x81, 0x05, 0x6b, 0xbe, 0x33, 0x20,
+	0x71, 0xe8, 0xb8, 0x44, 0xa2, 0x9b, 0x7d, 0xc7, 0x3d, 0x62, 0x23, 0x5a, 0x78, 0xba, 0x11, 0xd9,
+	0x62, 0x44, 0x6b, 0x37, 0xa7, 0xb2, 0xc6, 0x8f, 0x11, 0x8b, 0xfe, 0xd8, 0x82, 0xd5, 0x20, 0x0a,
+	0x7b, 0x8e, 0x4f, 0xda, 0x12, 0x1b, 0xd7, 0x2b, 0xec, 0xc4, 0x7d, 0x71, 0x8e, 0xf5, 0xb9, 0x95,
+	0xe5, 0xb9, 0x1f, 0xf8, 0x5e, 0x12, 0x44, 0x2d, 0x92, 0x24, 0x9e, 0xdf, 0x8d, 0x9b, 0xe7, 0x1e,
+	0x9e, 0xac, 0xaf, 0x8e, 0x51, 0xe1, 0xf1, 0xc1, 0xa0, 0x77, 0x2d, 0x58, 0x1c, 0x38, 0x9e, 0x9f,
+	0x10, 0xdf, 0xf1, 0x5d, 0x52, 0xaf, 0xb2, 0xc1, 0xed, 0xcf, 0xbf, 0x79, 0xf6, 0x35, 0x53, 0x7e,
+	0xfa, 0x0c, 0x00, 0x36, 0x45, 0xda, 0x7f, 0x5b, 0x84, 0x45, 0xe3, 0xb8, 0x3c, 0x07, 0xfb, 0xdb,
+	0x4f, 0xd9, 0xdf, 0xeb, 0xf9, 0x1c, 0xf3, 0x69, 0x06, 0x18, 0x25, 0xb0, 0x10, 0x27, 0x4e, 0x32,
+	0x8c, 0xd9, 0x51, 0x5e, 0x7c, 0x7d, 0x2f, 0x27, 0x79, 0x8c, 0x67, 0x73, 0x45, 0x48, 0x5c, 0xe0,
+	0xcf, 0x58, 0xc8, 0x42, 0x6f, 0x43, 0x2d, 0x08, 0xe9, 0xcd, 0x4a, 0x6d, 0x48, 0x89, 0x09, 0xde,
+	0x9e, 0x67, 0xcb, 0x49, 0x5e, 0xcd, 0xe5, 0x87, 0x27, 0xeb, 0x35, 0xf5, 0x88, 0xb5, 0x14, 0xdb,
+	0x85, 0x8f, 0x18, 0xe3, 0xdb, 0x0a, 0xfc, 0xb6, 0xc7, 0x16, 0xf4, 0x02, 0x94, 0x92, 0xe3, 0x50,
+	0x5e, 0xdd, 0x4a, 0x45, 0x87, 0xc7, 0x21, 0xc1, 0x0c, 0x43, 0x2f, 0xeb, 0x01, 0x89, 0x63, 0xa7,
+	0x4b, 0xb2, 0x97, 0xf5, 0x3e, 0x07, 0x63, 0x89, 0xb7, 0xdf, 0x86, 0x97, 0x26, 0xdb, 0x56, 0xf4,
+	0x49, 0x58, 0x88, 0x49, 0x34, 0x22, 0x91, 0x10, 0xa4, 0x35, 0xc3, 0xa0, 0x58, 0x60, 0xd1, 0x06,
+	0xd4, 0xd4, 0x99, 0x15, 0xe2, 0x56, 0x05, 0x69, 0x4d, 0x1f, 0x74, 0x4d, 0x63, 0xff, 0x8b, 0x05,
+	0x67, 0x0c, 0x99, 0xcf, 0xe1, 0x0a, 0x3d, 0x4a, 0x5f, 0xa1, 0x57, 0xf2, 0xd9, 0x31, 0x53, 0xee,
+	0xd0, 0xbf, 0x5a, 0x80, 0x55, 0x73, 0x5f, 0x31, 0xcb, 0xc0, 0xfc, 0x27, 0x12, 0x06, 0xb7, 0xf1,
+	0x5e, 0xdd, 0x4a, 0x2f, 0x09, 0xe6, 0x60, 0x2c, 0xf1, 0x74, 0x7d, 0x43, 0x27, 0xe9, 0xd5, 0x0b,
+	0xe9, 0xf5, 0x3d, 0x70, 0x92, 0x1e, 0x66, 0x18, 0xf4, 0xb3, 0xb0, 0x92, 0x38, 0x51, 0x97, 0x24,
+	0x98, 0x8c, 0xbc, 0x58, 0xee, 0xc8, 0x5a, 0xf3, 0x25, 0x41, 0xbb, 0x72, 0x98, 0xc2, 0xe2, 0x0c,
+	0x35, 0xf2, 0xa1, 0xd4, 0x23, 0xfd, 0x81, 0x30, 0x9d, 0x07, 0x39, 0x1d, 0x20, 0x36, 0xd1, 0x6b,
+	0xa4, 0x3f, 0x68, 0x56, 0xe9, 0x78, 0xe9, 0x2f, 0xcc, 0xe4, 0xa0, 0x5f, 0xb5, 0xa0, 0x76, 0x34,
+	0x8c, 0x93, 0x60, 0xe0, 0xbd, 0x23, 0x6d, 0xe2, 0xed, 0x3c, 0xa5, 0xde, 0x90, 0xcc, 0xf9, 0x71,
+	0x52, 0x8f, 0x58, 0x8b, 0x45, 0xef, 0x40, 0xe5, 0x28, 0x0e, 0x7c, 0x9f, 0x24, 0xf5, 0x1a, 0x1b,
+	0x41, 0x2b, 0xd7, 0x11, 0x70, 0xd6, 0xcd, 0x45, 0xba, 0xa4, 0xe2, 0x01, 0x4b, 0x81, 0x4c, 0x01,
+	0x6d, 0x2f, 0x22, 0x6e, 0x12, 0x44, 0xc7, 0x75, 0xc8, 0x5f, 0x01, 0xdb, 0x92, 0x39, 0x57, 0x80,
+	0x7a, 0xc4, 0x5a, 0x2c, 0x1a, 0xc1, 0x42, 0xd8, 0x1f, 0x76, 0x3d, 0xbf, 0xbe, 0xc8, 0x06, 0x80,
+	0xf3, 0x1c, 0xc0, 0x01, 0xe3, 0xdc, 0x04, 0x6a, 0x20, 0xf8, 0x6f, 0x2c, 0xa4, 0xa1, 0x8b, 0x50,
+	0x76, 0x7b, 0x4e, 0x94, 0xd4, 0x97, 0xd8, 0x26, 0x55, 0xa7, 0x66, 0x8b, 0x02, 0x31, 0xc7, 0xd9,
+	0x7f, 0x67, 0xc1, 0xda, 0xf4, 0x59, 0xf1, 0xe3, 0xe3, 0x0e, 0xa3, 0x98, 0x9b, 0xbd, 0xaa, 0x79,
+	0x7c, 0x18, 0x18, 0x4b, 0x3c, 0xfa, 0x2a, 0x54, 0xee, 0x89, 0x75, 0x2e, 0xe4, 0xbf, 0xce, 0xd7,
+	0xc5, 0x3a, 0x2b, 0xf9, 0xd7, 0xe5, 0x5a, 0x0b, 0xa1, 0xf6, 0x9f, 0x15, 0xe0, 0xdc, 0xc4, 0x63,
+	0x81, 0x1a, 0x00, 0x23, 0xa7, 0x3f, 0x24, 0x57, 0xbc, 0x3e, 0x91, 0x9e, 0xf4, 0x0a, 0xbd, 0x55,
+	0xdf, 0x54, 0x50, 0x6c, 0x50, 0xa0, 0x5f, 0x02, 0x08, 0x9d, 0xc8, 0x19, 0x90, 0x84, 0x44, 0xd2,
+	0x76, 0x5d, 0x9b, 0x63, 0x32, 0x74, 0x10, 0x07, 0x92, 0xa1, 0xbe, 0xd3, 0x15, 0x28, 0xc6, 0x86,
+	0x3c, 0xea, 0x37, 0x47, 0xa4, 0x4f, 0x9c, 0x98, 0xb0, 0x40, 0x31, 0xe3, 0x37, 0x63, 0x8d, 0xc2,
+	0x26, 0x1d, 0xbd, 0x36, 0xd8, 0x14, 0xe2, 0x7a, 0x29, 0x7d, 0x6d, 0xb0, 0x49, 0xc6, 0x58, 0x60,
+	0xed, 0xff, 0xb5, 0xa0, 0x3e, 0x4d, 0xbb, 0x28, 0x84, 0x0a, 0x79, 0x90, 0xbc, 0xe9, 0x44, 0x5c,
+	0x4d, 0xf3, 0x45, 0x3d, 0x82, 0xe9, 0x9b, 0x4e, 0xa4, 0x57, 0x6d, 0x87, 0x73, 0xc7, 0x52, 0x0c,
+	0xea, 0x42, 0x29, 0xe9, 0x3b, 0x79, 0x04, 0x59, 0x86, 0x38, 0x7d, 0x37, 0xef, 0x6d, 0xc6, 0x98,
+	0x09, 0xb0, 0xff, 0x61, 0xd2, 0xbc, 0x85, 0xc1, 0xa0, 0x3a, 0x27, 0xfe, 0xc8, 0x8b, 0x02, 0x7f,
+	0x40, 0xfc, 0x24, 0x1b, 0x9c, 0xef, 0x68, 0x14, 0x36, 0xe9, 0xd0, 0x2f, 0x4f, 0xd8, 0x28, 0x37,
+	0xe6, 0x98, 0x82, 0x18, 0xce, 0xcc, 0x7b, 0xc5, 0xfe, 0x41, 0x61, 0xc2, 0xe9, 0x55, 0x56, 0x18,
+	0xbd, 0x0e, 0x40, 0xaf, 0xff, 0x83, 0x88, 0x74, 0xbc, 0x07, 0x62, 0x56, 0x8a, 0xe5, 0x4d, 0x85,
+	0xc1, 0x06, 0x15, 0xba, 0x04, 0x0b, 0xde, 0xc0, 0xe9, 0x12, 0xea, 0xe6, 0xd1, 0x83, 0xf2, 0x32,
+	0xdd, 0x43, 0xbb, 0x0c, 0xf2, 0xe8, 0x64, 0x7d, 0x45, 0x31, 0x67, 0x20, 0x2c, 0x68, 0xd1, 0x9f,
+	0x58, 0xb0, 0xe4, 0x06, 0x83, 0x41, 0xe0, 0xef, 0x39, 0x77, 0x49, 0x5f, 0x46, 0x6f, 0xdd, 0x67,
+	0x72, 0xd9, 0x34, 0xb6, 0x0c, 0x49, 0x3b, 0x7e, 0x12, 0x1d, 0xeb, 0x80, 0xd4, 0x44, 0xe1, 0xd4,
+	0x90, 0xd6, 0x3e, 0x0f, 0xab, 0x63, 0x2f, 0xa2, 0xb3, 0x50, 0x3c, 0x22, 0xc7, 0x5c, 0x37, 0x98,
+	0xfe, 0x44, 0x1f, 0x81, 0x32, 0x3b, 0x2a, 0xdc, 0x0f, 0xc0, 0xfc, 0xe1, 0xa7, 0x0b, 0x97, 0x2d,
+	0xfb, 0x8f, 0x2c, 0xf8, 0xe8, 0x14, 0x03, 0x4c, 0x9d, 0x07, 0x5f, 0xe7, 0x75, 0xd4, 0x06, 0x64,
+	0xe7, 0x94, 0x61, 0xd0, 0x97, 0xa1, 0x48, 0xfc, 0x91, 0xd8, 0x25, 0x5b, 0x73, 0x28, 0x66, 0xc7,
+	0x1f, 0xf1, 0x49, 0x57, 0x1e, 0x9e, 0xac, 0x17, 0x77, 0xfc, 0x11, 0xa6, 0x8c, 0xed, 0x6f, 0x97,
+	0x53, 0xee, 0x5d, 0x4b, 0xfa, 0xec, 0x6c, 0x94, 0x75, 0x2b, 0x57, 0x9f, 0x9d, 0x07, 0x88, 0xda,
+	0x33, 0x65, 0xcf, 0x58, 0xc8, 0x42, 0x5f, 0xb3, 0x58, 0xe8, 0x2f, 0x3d, 0x5a, 0x71, 0x1d, 0x3c,
+	0x83, 0x34, 0x84, 0x99, 0x4d, 0x90, 0x40, 0x6c, 0x8a, 0xa6, 0xf7, 0x57, 0xc8, 0x03, 0x39, 0x61,
+	0x48, 0x95, 0x25, 0x92, 0xc9, 0x01, 0x89, 0x47, 0x43, 0x80, 0xf8, 0xd8, 0x77, 0x0f, 0x82, 0xbe,
+	0xe7, 0x1e, 0x8b, 0x50, 0x63, 0x1e, 0x7b, 0xd4, 0x52, 0xcc, 0xf8, 0x65, 0xa3, 0x9f, 0xb1, 0x21,
+	0x08, 0x7d, 0xc3, 0x82, 0x55, 0xaf, 0xeb, 0x07, 0x11, 0xd9, 0xf6, 0x3a, 0x1d, 0x12, 0x11, 0x9f,
+	0x06, 0xd7, 0x3c, 0xf7, 0x70, 0x38, 0x87, 0x78, 0x19, 0x1b, 0xef, 0x66, 0x79, 0x37, 0x3f, 0x26,
+	0x54, 0xb0, 0x3a, 0x86, 0xc2, 0xe3, 0x23, 0x41, 0x0e, 0x94, 0x3c, 0xbf, 0x13, 0x88, 0xdc, 0xc3,
+	0xe7, 0xe7, 0x18, 0xd1, 0xae, 0xdf, 0x09, 0xf4, 0xc9, 0xa0, 0x4f, 0x98, 0xb1, 0xb6, 0xff, 0xa7,
+	0x9a, 0xf6, 0xdc, 0x79, 0xe4, 0xf7, 0x0e, 0xd4, 0x22, 0x95, 0x6c, 0xe0, 0xb7, 0xd1, 0x6e, 0x0e,
+	0xfa, 0x10, 0xf1, 0xa6, 0x0a, 0x95, 0x74, 0x5a, 0x41, 0x8b, 0xa3, 0xb7, 0x12, 0x5d, 0x22, 0xb1,
+	0x73, 0xe7, 0xdd, 0x05, 0x42, 0xa4, 0x0e, 0xaa, 0x8f, 0x7d, 0x1a, 0x54, 0x1f, 0xfb, 0x2e, 0x0a,
+	0x60, 0xa1, 0x47, 0x9c, 0x7e, 0xd2, 0x13, 0x41, 0xf5, 0xd5, 0xb9, 0xdc, 0x0c, 0xca, 0x28, 0x1b,
+	0x4f, 0x73, 0x28, 0x16, 0x62, 0xd0, 0x10, 0x2a, 0x3d, 0x2f, 0x66, 0xee, 0x30, 0x37, 0xd1, 0xd7,
+	0xe7, 0xd2, 0x29, 0x0f, 0x6c, 0xae, 0x71, 0x8e, 0xfa, 0x70, 0x09, 0x00, 0x96, 0xb2, 0xd0, 0xaf,
+	0x59, 0x00, 0xae, 0x8c, 0xa4, 0xe5, 0xf6, 0xbe, 0x95, 0x8f, 0x45, 0x50, 0x11, 0xba, 0xbe, 0xdb,
+	0x14, 0x28, 0xc6, 0x86, 0x58, 0xf4, 0x16, 0x2c, 0x45, 0xc4, 0x0d, 0x7c, 0xd7, 0xeb, 0x93, 0xf6,
+	0x26, 0xcd, 0xa7, 0x51, 0x9d, 0xff, 0xd8, 0x6c, 0x11, 0xef, 0xa1, 0x37, 0x20, 0xcd, 0xb3, 0xf4,
+	0x8e, 0xc1, 0x06, 0x0f, 0x9c, 0xe2, 0x88, 0x7e, 0xc3, 0x82, 0x15, 0x95, 0x49, 0xa0, 0x4b, 0x41,
+	0x44, 0xb0, 0xb7, 0x9b, 0x47, 0xd2, 0x82, 0x31, 0x6c, 0x22, 0x1a, 0x69, 0xa6, 0x61, 0x38, 0x23,
+	0x14, 0x7d, 0x01, 0x20, 0xb8, 0xcb, 0x12, 0x05, 0x74, 0x9e, 0xd5, 0x27, 0x9e, 0xe7, 0x0a, 0x4f,
+	0x3a, 0x49, 0x0e, 0xd8, 0xe0, 0x86, 0x6e, 0x00, 0xf0, 0x73, 0x42, 0x33, 0x1f, 0x2c, 0xa6, 0xab,
+	0x35, 0x3f, 0x2d, 0x35, 0xdf, 0x52, 0x98, 0x47, 0x27, 0xeb, 0xe3, 0xfe, 0x38, 0x45, 0x60, 0xe3,
+	0x75, 0xf4, 0x00, 0x2a, 0xf1, 0x70, 0x30, 0x70, 0x54, 0x78, 0xb6, 0x9f, 0xd3, 0x15, 0xc5, 0x99,
+	0xea, 0x2d, 0x29, 0x00, 0x58, 0x8a, 0xb3, 0x7d, 0x40, 0xe3, 0xf4, 0xe8, 0x12, 0x2c, 0x91, 0x07,
+	0x09, 0x89, 0x7c, 0xa7, 0x7f, 0x1b, 0xef, 0xc9, 0x68, 0x81, 0x2d, 0xfb, 0x8e, 0x01, 0xc7, 0x29,
+	0x2a, 0x64, 0x2b, 0xa7, 0xa9, 0xc0, 0xe8, 0x41, 0x3b, 0x4d, 0xd2, 0x45, 0xb2, 0x7f, 0xb3, 0x90,
+	0xba, 0x9f, 0x0f, 0x23, 0x42, 0x50, 0x1f, 0xca, 0x7e, 0xd0, 0x56, 0xf6, 0xed, 0x6a, 0x0e, 0xf6,
+	0xed, 0x66, 0xd0, 0x36, 0xb2, 0xdd, 0xf4, 0x29, 0xc6, 0x5c, 0x08, 0xfa, 0x75, 0x0b, 0x96, 0x65,
+	0xea, 0x94, 0x21, 0xea, 0x85, 0x7c, 0xc5, 0x9e, 0x13, 0x62, 0x97, 0x6f, 0x99, 0x52, 0x70, 0x5a,
+	0xa8, 0xfd, 0x7d, 0x2b, 0x15, 0xa8, 0xdd, 0x71, 0x12, 0xb7, 0xb7, 0x33, 0xa2, 0xfe, 0xf4, 0x8d,
+	0x54, 0x86, 0xed, 0xa7, 0xcc, 0x0c, 0xdb, 0xa3, 0x93, 0xf5, 0x4f, 0x4d, 0x2b, 0xc5, 0xdd, 0xa7,
+	0x1c, 0x1a, 0x8c, 0x85, 0x91, 0x8c, 0xfb, 0x0a, 0x2c, 0x1a, 0x23, 0x16, 0xa6, 0x3c, 0xaf, 0x14,
+	0x94, 0xf2, 0x3c, 0x0c, 0x20, 0x36, 0xe5, 0xd9, 0xbf, 0x5f, 0x84, 0x8a, 0xa8, 0x00, 0xcc, 0x9c,
+	0xd2, 0x93, 0x4e, 0x64, 0x61, 0xaa, 0x13, 0x19, 0xc2, 0x82, 0xcb, 0xea, 0x89, 0xe2, 0xbe, 0x98,
+	0x27, 0x2c, 0x15, 0xa3, 0xe3, 0xf5, 0x49, 0x3d, 0x26, 0xfe, 0x8c, 0x85, 0x1c, 0x5a, 0x22, 0x39,
+	0xe3, 0xd2, 0xb0, 0xc4, 0xd5, 0x26, 0xad, 0x34, 0x77, 0xc2, 0x79, 0x2b, 0xcd, 0xb1, 0xf9, 0x51,
+	0x21, 0xfd, 0x4c, 0x06, 0x81, 0xb3, 0xb2, 0xd1, 0xcf, 0xc0, 0x32, 0xd7, 0xd6, 0x9b, 0x24, 0x62,
+	0x29, 0xb8, 0x32, 0x53, 0x96, 0xda, 0x7a, 0x2d, 0x13, 0x89, 0xd3, 0xb4, 0xf6, 0xb7, 0x8a, 0xb0,
+	0x9c, 0x9a, 0x36, 0xfa, 0x71, 0xa8, 0x0e, 0x63, 0x12, 0x19, 0xbe, 0xbb, 0x4a, 0x68, 0xde, 0x16,
+	0x70, 0xac, 0x28, 0x28, 0x75, 0xe8, 0xc4, 0xf1, 0xfd, 0x20, 0x6a, 0xd7, 0x0b, 0x69, 0xea, 0x03,
+	0x01, 0xc7, 0x8a, 0x82, 0x46, 0x95, 0x77, 0x89, 0x13, 0x91, 0xe8, 0x30, 0x38, 0x22, 0x63, 0x15,
+	0xb0, 0xa6, 0x46, 0x61, 0x93, 0x8e, 0x69, 0x3c, 0xe9, 0xc7, 0x5b, 0x7d, 0x8f, 0xf8, 0x09, 0x1f,
+	0x66, 0x0e, 0x1a, 0x3f, 0xdc, 0x6b, 0x99, 0x1c, 0xb5, 0xc6, 0x33, 0x08, 0x9c, 0x95, 0x8d, 0x7e,
+	0xc5, 0x82, 0x65, 0xe7, 0x7e, 0xac, 0x6b, 0xd9, 0xf5, 0xf2, 0xdc, 0x7b, 0x2f, 0x55, 0x1b, 0x6f,
+	0xae, 0xd2, 0x85, 0x4b, 0x81, 0x70, 0x5a, 0xa2, 0xfd, 0x81, 0x05, 0xb2, 0x46, 0xfe, 0x1c, 0xf2,
+	0xd6, 0xdd, 0x74, 0xde, 0xba, 0x39, 0xff, 0x21, 0x9b, 0x92, 0xb3, 0xbe, 0x09, 0x15, 0x1a, 0x92,
+	0x3a, 0x7e, 0x1b, 0x7d, 0x02, 0x2a, 0x2e, 0xff, 0x29, 0xee, 0x1c, 0x96, 0xd1, 0x14, 0x58, 0x2c,
+	0x71, 0xe8, 0x65, 0x28, 0x39, 0x51, 0x57, 0xde, 0x33, 0x2c, 0xe1, 0xbb, 0x19, 0x75, 0x63, 0xcc,
+	0xa0, 0xf6, 0x7b, 0x05, 0x80, 0xad, 0x60, 0x10, 0x3a, 0x11, 0x69, 0x1f, 0x06, 0xff, 0xef, 0xc3,
+	0x3f, 0xfb, 0x77, 0x2c, 0x40, 0x54, 0x1f, 0x81, 0x4f, 0x7c, 0x9d, 0x56, 0xa1, 0xa5, 0x13, 0x57,
+	0x42, 0xc5, 0xa9, 0x57, 0xf1, 0x80, 0x22, 0xc7, 0x9a, 0x66, 0x06, 0xc3, 0x7c, 0x51, 0x66, 0x0d,
+	0x8a, 0xe9, 0x64, 0x2b, 0xcb, 0xbe, 0x89, 0x24, 0x82, 0xfd, 0xbb, 0x05, 0x78, 0x89, 0x6f, 0xe8,
+	0x7d, 0xc7, 0x77, 0xba, 0x84, 0x26, 0x91, 0x66, 0xce, 0x1f, 0xbc, 0x45, 0x03, 0x31, 0x4f, 0x26,
+	0x57, 0xe7, 0xda, 0x93, 0x7c, 0x2f, 0xf1, 0xdd, 0xb3, 0xeb, 0x7b, 0x09, 0x66, 0x9c, 0x51, 0x08,
+	0x55, 0xd9, 0xc6, 0x52, 0x2f, 0xe6, 0x26, 0x45, 0x1d, 0xb4, 0xab, 0x82, 0x37, 0x56, 0x52, 0xec,
+	0xef, 0x58, 0x90, 0xb5, 0xf8, 0xec, 0xb2, 0xe4, 0x75, 0xc6, 0xec, 0x65, 0x99, 0xae, 0x0c, 0xce,
+	0x5e, 0x6c, 0x43, 0x5f, 0x82, 0x45, 0x27, 0x49, 0xc8, 0x20, 0x4c, 0x98, 0x3b, 0x5c, 0x7c, 0x3a,
+	0x77, 0x78, 0x3f, 0x68, 0x7b, 0x1d, 0x8f, 0xb9, 0xc3, 0x26, 0x3b, 0xfb, 0x0d, 0xa8, 0xca, 0x94,
+	0xcc, 0x0c, 0xcb, 0x78, 0x31, 0x95, 0x5e, 0x9a, 0xb2, 0x51, 0x1c, 0x58, 0x32, 0xa3, 0xb9, 0x67,
+	0xa0, 0x13, 0xfb, 0x3d, 0x0b, 0x96, 0x53, 0x89, 0xe9, 0x9c, 0xc6, 0x4e, 0x6f, 0xbd, 0x4e, 0xc0,
+	0x02, 0xed, 0xc8, 0xf3, 0xb9, 0x9f, 0x52, 0xd5, 0x47, 0xf5, 0x8a, 0x46, 0x61, 0x93, 0xce, 0xde,
+	0x07, 0x96, 0x12, 0xc8, 0x4b, 0x83, 0x6f, 0x40, 0x95, 0xb2, 0xa3, 0xd6, 0x36, 0x2f, 0x96, 0x2d,
+	0xa8, 0x5e, 0xbf, 0x73, 0xc8, 0xef, 0x68, 0x1b, 0x8a, 0x9e, 0xc3, 0x6d, 0x47, 0x51, 0xef, 0xf0,
+	0xdd, 0x38, 0x1e, 0xb2, 0xfd, 0x41, 0x91, 0xe8, 0x22, 0x14, 0xc9, 0x83, 0x90, 0xb1, 0x2c, 0x6a,
+	0xfb, 0xb2, 0xf3, 0x20, 0xf4, 0x22, 0x12, 0x53, 0x22, 0xf2, 0x20, 0xb4, 0x87, 0x00, 0x3a, 0x71,
+	0x9d, 0xd7, 0x12, 0x5c, 0x80, 0x92, 0x1b, 0xb4, 0x89, 0xd0, 0xbd, 0x62, 0xb3, 0x15, 0xb4, 0x09,
+	0x66, 0x18, 0xfb, 0xeb, 0x16, 0x9c, 0xcd, 0x66, 0x9b, 0x7f, 0x68, 0x66, 0x71, 0x0f, 0xce, 0xaa,
+	0xdc, 0xee, 0xad, 0x90, 0x87, 0xea, 0x97, 0x61, 0xe9, 0xee, 0xd0, 0xeb, 0xb7, 0xc5, 0xb3, 0x18,
+	0x8e, 0x4a, 0xf3, 0x36, 0x0d, 0x1c, 0x4e, 0x51, 0xda, 0x31, 0xe8, 0xb2, 0x3e, 0xea, 0x88, 0x44,
+	0x8e, 0x35, 0xb7, 0xc7, 0x42, 0x93, 0x36, 0x8a, 0x2f, 0x37, 0x9d, 0x3a, 0x8f, 0x63, 0xff, 0x69,
+	0x09, 0x32, 0x21, 0x39, 0x1a, 0x9a, 0x9d, 0x0b, 0x56, 0x8e, 0x9d, 0x0b, 0x6a, 0x4d, 0x26, 0x75,
+	0x2f, 0xa0, 0xcf, 0x42, 0x39, 0xec, 0x39, 0xb1, 0x5c, 0x94, 0x75, 0xa9, 0xf1, 0x03, 0x0a, 0x7c,
+	0x64, 0x66, 0x0e, 0x18, 0x04, 0x73, 0x6a, 0xd3, 0x72, 0x14, 0x4f, 0xb1, 0xa6, 0x5f, 0xe5, 0x89,
+	0x52, 0x4c, 0xe2, 0x61, 0x3f, 0x11, 0x9e, 0xe9, 0xcd, 0xbc, 0x34, 0xcb, 0xb9, 0xea, 0x8c, 0x29,
+	0x7f, 0xc6, 0x86, 0x44, 0xf4, 0x45, 0xa8, 0xc5, 0x89, 0x13, 0x25, 0x4f, 0x99, 0xc2, 0x51, 0xea,
+	0x6b, 0x49, 0x26, 0x58, 0xf3, 0xa3, 0x89, 0x93, 0x8e, 0xe7, 0x7b, 0x71, 0x8f, 0x71, 0xaf, 0x3c,
+	0xdd, 0x4d, 0x71, 0x45, 0x71, 0xc0, 0x06, 0x37, 0xfb, 0xe7, 0xe0, 0xc2, 0x69, 0x2d, 0x4f, 0xd4,
+	0xbf, 0xbb, 0xef, 0x44, 0xbe, 0xa8, 0xb6, 0xb2, 0x6d, 0x76, 0xc7, 0x89, 0x7c, 0xcc, 0xa0, 0xf6,
+	0x5f, 0x5b, 0x80, 0xc6, 0x1b, 0x93, 0xe8, 0xe2, 0x11, 0xdf, 0xb9, 0xdb, 0x27, 0xed, 0x6c, 0x95,
+	0x76, 0x87, 0x83, 0xb1, 0xc4, 0xd3, 0x6a, 0xfc, 0x7d, 0xcf, 0x6f, 0x07, 0xf7, 0xa5, 0x73, 0xdb,
+	0xca, 0xb5, 0x47, 0xea, 0x0e, 0xe3, 0xcd, 0x7d, 0x57, 0xfe, 0x3b, 0xc6, 0x52, 0x20, 0xcd, 0x80,
+	0xd4, 0xa7, 0xbd, 0x42, 0x43, 0x2b, 0xda, 0x4a, 0xdb, 0x1e, 0xf6, 0xc7, 0x02, 0xb1, 0x96, 0x80,
+	0x63, 0x45, 0x41, 0xa9, 0xdb, 0xc3, 0x48, 0xfb, 0x97, 0x06, 0xf5, 0xb6, 0x80, 0x63, 0x45, 0x41,
+	0x93, 0x3a, 0xc6, 0xf8, 0x65, 0x65, 0x8b, 0x25, 0x75, 0x0c, 0xd7, 0x32, 0xc6, 0x29, 0x2a, 0x5a,
+	0x36, 0x56, 0xcd, 0x33, 0xbc, 0xa0, 0x25, 0xca, 0xc6, 0xaa, 0xbb, 0x26, 0xc6, 0x06, 0x05, 0x7a,
+	0x05, 0xaa, 0xa2, 0xb1, 0x8f, 0x27, 0x38, 0x6b, 0xcd, 0x25, 0x3a, 0x1e, 0x11, 0x01, 0xc4, 0x58,
+	0x61, 0xed, 0x6f, 0x16, 0x60, 0xd1, 0x68, 0x4e, 0x9c, 0xc1, 0xec, 0x67, 0x9a, 0x29, 0x0b, 0x33,
+	0x36, 0x53, 0xbe, 0x02, 0xd5, 0x90, 0x96, 0x19, 0x3c, 0x55, 0xce, 0x63, 0x43, 0x3a, 0x10, 0x30,
+	0xac, 0xb0, 0x28, 0x81, 0xda, 0xbd, 0xfb, 0x09, 0xbb, 0xdc, 0x64, 0xf1, 0x6e, 0x9e, 0x1a, 0x95,
+	0xbc, 0x28, 0xf5, 0x69, 0x93, 0x90, 0x18, 0x6b, 0x41, 0x34, 0x6f, 0xd6, 0xa5, 0x6d, 0x8a, 0x52,
+	0x61, 0x2c, 0x6f, 0xc6, 0x1a, 0x17, 0x63, 0x2c, 0x30, 0xf6, 0x49, 0x19, 0x80, 0xf5, 0xb7, 0x7a,
+	0x2c, 0x93, 0x7c, 0x01, 0x4a, 0x11, 0x09, 0x83, 0xac, 0xae, 0x28, 0x05, 0x66, 0x98, 0x54, 0x48,
+	0x5f, 0x78, 0xa2, 0x90, 0xbe, 0x78, 0x6a, 0x48, 0x4f, 0xb3, 0x0f, 0x71, 0xef, 0x20, 0xf2, 0x46,
+	0x4e, 0x42, 0x6e, 0x90, 0xe3, 0x7a, 0x29, 0x93, 0x7d, 0x68, 0x5d, 0xd3, 0x48, 0x9c, 0xa6, 0x9d,
+	0x98, 0x4a, 0x29, 0xff, 0x10, 0x53, 0x29, 0x2d, 0x38, 0xe7, 0xf9, 0x31, 0x6d, 0xdf, 0x10, 0x55,
+	0xa2, 0x6b, 0x41, 0x9c, 0xd0, 0x49, 0x2d, 0x30, 0x23, 0xf2, 0x71, 0xc1, 0xe8, 0xdc, 0xee, 0x24,
+	0x22, 0x3c, 0xf9, 0x5d, 0xaa, 0x4f, 0x89, 0x60, 0xe6, 0xb3, 0x6a, 0xb8, 0x47, 0x02, 0x8e, 0x15,
+	0x05, 0x75, 0x39, 0xb8, 0x65, 0xda, 0xeb, 0xc4, 0x2c, 0x4d, 0x5d, 0x35, 0x3c, 0x25, 0x8e, 0xb8,
+	0xd2, 0xc2, 0x9a, 0x06, 0x5d, 0x85, 0x55, 0x9d, 0x9f, 0x20, 0x51, 0xb2, 0x4d, 0x33, 0x00, 0x3c,
+	0x07, 0xad, 0xea, 0x5a, 0x3a, 0xa3, 0x21, 0x08, 0xf0, 0xf8, 0x3b, 0x68, 0x1b, 0xce, 0xa6, 0x80,
+	0x37, 0x08, 0xcf, 0x40, 0xd7, 0x9a, 0x75, 0xc1, 0xe7, 0x6c, 0x8a, 0x0f, 0x9d, 0xf2, 0xd8, 0x1b,
+	0xaa, 0x27, 0x70, 0x71, 0x6a, 0x4f, 0xa0, 0x3c, 0xdb, 0x4b, 0xd3, 0xce, 0xb6, 0xfd, 0xb5, 0x02,
+	0x9c, 0xd3, 0x1b, 0x9c, 0x72, 0xf6, 0x3a, 0x74, 0x95, 0x59, 0xfd, 0x9e, 0xe7, 0xaf, 0x8c, 0x4f,
+	0x06, 0x54, 0x8d, 0xa3, 0xa5, 0x30, 0xd8, 0xa0, 0xa2, 0xfa, 0x77, 0x49, 0xc4, 0x12, 0xa1, 0xd9,
+	0xdd, 0xbf, 0x25, 0xe0, 0x58, 0x51, 0xb0, 0xaf, 0x12, 0x48, 0x94, 0xb4, 0x86, 0x77, 0xd9, 0x0b,
+	0x99, 0x14, 0xd5, 0x96, 0x46, 0x61, 0x93, 0x8e, 0x99, 0x3a, 0xa9, 0x7c, 0x7a, 0x02, 0x96, 0x84,
+	0xa9, 0x93, 0xfa, 0x56, 0x58, 0x39, 0x1c, 0xea, 0x8b, 0xd7, 0xcb, 0xe3, 0xc3, 0xa1, 0x70, 0xac,
+	0x28, 0xec, 0xff, 0xb2, 0xe0, 0x63, 0x13, 0x55, 0xf1, 0x1c, 0x92, 0x3e, 0xc3, 0x74, 0xd2, 0xe7,
+	0x60, 0xae, 0xa4, 0xf8, 0x84, 0x29, 0x4c, 0x49, 0x01, 0xfd, 0xa3, 0x05, 0x2b, 0x9a, 0xfe, 0x39,
+	0xcc, 0xb3, 0x93, 0xdf, 0x77, 0x0d, 0x7a, 0xdc, 0xcd, 0xda, 0xd8, 0xc4, 0xbe, 0x55, 0xa0, 0x13,
+	0xe3, 0x6e, 0xce, 0xa6, 0x2b, 0x3b, 0x68, 0x4f, 0xb9, 0xe7, 0x68, 0xaf, 0x1c, 0x8d, 0x47, 0xe4,
+	0xe8, 0x6e, 0xe6, 0x50, 0x9a, 0xe0, 0xc2, 0x59, 0x98, 0xa3, 0x03, 0x67, 0xf6, 0x18, 0x63, 0x21,
+	0x8d, 0xda, 0x21, 0x67, 0xe4, 0x78, 0x7d, 0x6a, 0x66, 0xea, 0xc5, 0xb4, 0x1d, 0xda, 0x94, 0x08,
+	0xac, 0x69, 0xa8, 0x73, 0x60, 0xd4, 0x33, 0x0d, 0xe7, 0x60, 0x4a, 0xe9, 0xf1, 0x55, 0xa8, 0x78,
+	0xfe, 0x88, 0xd0, 0x46, 0xba, 0x72, 0xda, 0xbf, 0xde, 0xe5, 0x60, 0x2c, 0xf1, 0xf6, 0x00, 0xea,
+	0xe9, 0xa1, 0x6f, 0x13, 0xea, 0x44, 0xce, 0xa8, 0x41, 0x3a, 0x13, 0xf6, 0xd6, 0xde, 0xd0, 0xc9,
+	0xb6, 0x05, 0x6f, 0x4a, 0x04, 0xd6, 0x34, 0xf6, 0x9f, 0x5b, 0xf0, 0xe2, 0x04, 0x55, 0xe5, 0x18,
+	0x8b, 0x26, 0xda, 0xb4, 0x4c, 0xe9, 0x9a, 0x6e, 0x93, 0x8e, 0x23, 0x83, 0x09, 0x43, 0x35, 0xdb,
+	0x1c, 0x8c, 0x25, 0xde, 0xfe, 0x0f, 0x0b, 0xce, 0xa4, 0xc7, 0x1a, 0xa3, 0xeb, 0x80, 0xf8, 0x64,
+	0xb6, 0xbd, 0xd8, 0x0d, 0x46, 0x24, 0x3a, 0xa6, 0x33, 0xe7, 0xa3, 0x5e, 0x13, 0x9c, 0xd0, 0xe6,
+	0x18, 0x05, 0x9e, 0xf0, 0x16, 0xfa, 0x3a, 0x4b, 0x5d, 0x4a, 0x6d, 0xe7, 0xe1, 0x22, 0x4f, 0x5b,
+	0x49, 0xd3, 0x79, 0x53, 0xf2, 0xb0, 0x29, 0xdc, 0xfe, 0xa0, 0x00, 0x4b, 0xf2, 0x75, 0xda, 0x91,
+	0x41, 0xf5, 0xcd, 0x7c, 0xa2, 0xba, 0x95, 0xd6, 0x37, 0x73, 0x98, 0x30, 0xc7, 0x51, 0x7d, 0x1f,
+	0x79, 0x7e, 0x3b, 0x1b, 0x93, 0xd3, 0x0f, 0x41, 0x30, 0xc3, 0xa4, 0x1b, 0xc7, 0x8b, 0xa7, 0x37,
+	0x8e, 0xab, 0x9d, 0x50, 0x7a, 0x9c, 0x7b, 0xca, 0x5b, 0x9d, 0xb5, 0x53, 0x63, 0x5c, 0x23, 0x87,
+	0x1a, 0x85, 0x4d, 0x3a, 0x3a, 0x92, 0xbe, 0x37, 0x22, 0xfc, 0xa5, 0x85, 0xf4, 0x48, 0xf6, 0x24,
+	0x02, 0x6b, 0x1a, 0x3a, 0x92, 0xb6, 0xd7, 0xe9, 0xd4, 0x2b, 0xe9, 0x91, 0x50, 0xed, 0x60, 0x86,
+	0xa1, 0x14, 0xbd, 0x20, 0x38, 0x12, 0xbe, 0x84, 0xa2, 0xb8, 0x16, 0x04, 0x47, 0x98, 0x61, 0xec,
+	0x1f, 0xb0, 0x3b, 0x66, 0x4a, 0x73, 0x4c, 0x5e, 0x3a, 0x96, 0x2a, 0x2b, 0x3e, 0xee, 0x9c, 0xea,
+	0x55, 0x28, 0xcd, 0xb0, 0x0a, 0x97, 0x60, 0x89, 0xb6, 0xba, 0x1e, 0x04, 0x9e, 0x6f, 0x84, 0x18,
+	0x2c, 0x88, 0xb9, 0xde, 0xba, 0x75, 0x53, 0xc2, 0x71, 0x8a, 0xca, 0xfe, 0x4e, 0x19, 0x5e, 0x52,
+	0x35, 0x5a, 0x92, 0xdc, 0x0f, 0xa2, 0x23, 0xcf, 0xef, 0xb2, 0x4c, 0xdb, 0x37, 0x2c, 0x58, 0xe2,
+	0xab, 0x21, 0x7a, 0xf6, 0x78, 0x11, 0xda, 0xcd, 0xa3, 0x1a, 0x9c, 0x92, 0xd4, 0x38, 0x34, 0xa4,
+	0x64, 0xfa, 0xf5, 0x4c, 0x14, 0x4e, 0x0d, 0x07, 0xbd, 0x03, 0x20, 0xfb, 0xe7, 0x3b, 0x79, 0x7c,
+	0x42, 0x20, 0x07, 0x87, 0x49, 0x47, 0x7b, 0x51, 0x87, 0x4a, 0x02, 0x36, 0xa4, 0xd1, 0x3e, 0x8e,
+	0x85, 0x3e, 0xd7, 0x4a, 0x91, 0x09, 0xfe, 0xf9, 0xfc, 0xb5, 0x62, 0xea, 0x43, 0xdd, 0x4b, 0x42,
+	0x13, 0x42, 0x38, 0xc2, 0xf4, 0xda, 0xe8, 0x46, 0x24, 0x96, 0x41, 0xd9, 0xa7, 0x0c, 0x4f, 0xa0,
+	0xe1, 0x06, 0x11, 0x61, 0xf7, 0x7e, 0xe0, 0xb4, 0x9b, 0x4e, 0x9f, 0x46, 0xd3, 0xd1, 0x2e, 0x27,
+	0x37, 0xef, 0x17, 0x06, 0xc0, 0x92, 0xd1, 0x58, 0x8b, 0x43, 0x79, 0x96, 0x16, 0x07, 0xda, 0x3d,
+	0x39, 0xb6, 0x8c, 0x4f, 0xd2, 0x3d, 0xb9, 0xf6, 0x39, 0x58, 0x7c, 0xca, 0x57, 0xed, 0x0f, 0xca,
+	0xda, 0x12, 0xd2, 0x1e, 0x02, 0x5a, 0xdb, 0x8f, 0xf4, 0x6a, 0x0a, 0x27, 0x29, 0xaf, 0xbd, 0x61,
+	0xf4, 0x5a, 0x2b, 0x20, 0x36, 0xe5, 0xd1, 0x9d, 0x19, 0x3a, 0x11, 0xf1, 0x9f, 0xe9, 0xce, 0x3c,
+	0x50, 0x12, 0xb0, 0x21, 0x0d, 0x11, 0xd1, 0x8f, 0x57, 0x9c, 0x3b, 0x46, 0x97, 0xf9, 0xf1, 0x49,
+	0x3d, 0x79, 0x34, 0x56, 0x5d, 0xf1, 0x53, 0xfb, 0xb5, 0x5e, 0x9a, 0xbb, 0x8e, 0x37, 0xf9, 0x20,
+	0xf0, 0x86, 0xa6, 0x34, 0x0c, 0x67, 0x84, 0xa3, 0x4d, 0x38, 0x23, 0x57, 0x20, 0x5d, 0xf8, 0x57,
+	0xe1, 0x2e, 0x4e, 0xa3, 0x71, 0x96, 0xde, 0x68, 0xd2, 0x59, 0x98, 0xd6, 0xa4, 0x83, 0x8e, 0x54,
+	0x3f, 0x5e, 0x25, 0xdf, 0x7e, 0x3c, 0x18, 0xef, 0xc5, 0xb3, 0xbf, 0x6d, 0xc1, 0x59, 0x39, 0xea,
+	0x5b, 0x23, 0x12, 0x45, 0x5e, 0x9b, 0xdd, 0x0b, 0x1c, 0xad, 0xbd, 0x18, 0x75, 0x2f, 0x5c, 0x93,
+	0x08, 0xac, 0x69, 0x68, 0x44, 0x3c, 0xde, 0x3f, 0x5a, 0x48, 0x47, 0xc4, 0x33, 0x75, 0x7a, 0xbe,
+	0x0a, 0x15, 0xee, 0x12, 0xc5, 0xd9, 0x14, 0xb0, 0x70, 0xb5, 0xb0, 0xc4, 0xdb, 0xff, 0x6d, 0x81,
+	0x79, 0x3a, 0x66, 0xbb, 0x35, 0x5f, 0x85, 0xca, 0x48, 0x2c, 0x5d, 0xa6, 0x38, 0x25, 0x97, 0x4c,
+	0xe2, 0xd5, 0x05, 0x5b, 0x9c, 0xcd, 0x89, 0x29, 0x3d, 0x81, 0x13, 0x53, 0x9e, 0x7a, 0x23, 0x7f,
+	0x1c, 0x8a, 0x43, 0xaf, 0x2d, 0xfc, 0x90, 0x45, 0x41, 0x50, 0xbc, 0xbd, 0xbb, 0x8d, 0x29, 0xdc,
+	0xfe, 0xb7, 0xa2, 0x8e, 0x67, 0x44, 0x26, 0xfa, 0x47, 0x62, 0xda, 0x97, 0x54, 0x6d, 0x91, 0xcf,
+	0xfc, 0xe5, 0x74, 0x6d, 0xf1, 0xd1, 0xc9, 0x3a, 0xf0, 0xe9, 0xb2, 0xf2, 0xd1, 0x84, 0x4a, 0x63,
+	0xe5, 0x94, 0x7a, 0xc1, 0x65, 0xa8, 0x52, 0xc7, 0x8b, 0x25, 0x18, 0xaa, 0x29, 0x11, 0xd5, 0x6b,
+	0x02, 0xfe, 0xc8, 0xf8, 0x8d, 0x15, 0x35, 0xda, 0x84, 0x1a, 0xfd, 0xcd, 0x0a, 0x15, 0x22, 0xc9,
+	0x73, 0x51, 0x9d, 0x05, 0x89, 0x98, 0x50, 0xd3, 0xd0, 0x6f, 0x51, 0x85, 0xb1, 0x66, 0x6b, 0xc6,
+	0x02, 0xd2, 0x0a, 0x6b, 0x49, 0x04, 0xd6, 0x34, 0xf6, 0x87, 0xc6, 0x32, 0x8b, 0xea, 0xeb, 0x8f,
+	0xc4, 0x32, 0x5f, 0xce, 0x2c, 0xf3, 0x85, 0xb1, 0x65, 0x5e, 0xd1, 0xbd, 0xca, 0xa9, 0xa5, 0x7e,
+	0x9e, 0x36, 0xf1, 0x74, 0xff, 0x9d, 0xdf, 0x04, 0x6f, 0x0f, 0xbd, 0x88, 0xc4, 0x07, 0xd1, 0xd0,
+	0xa7, 0x35, 0xe6, 0x1a, 0x23, 0x36, 0x6e, 0x82, 0x14, 0x1a, 0x67, 0xe9, 0xed, 0xbf, 0x2c, 0xc0,
+	0x99, 0x4c, 0xef, 0x32, 0x4d, 0x54, 0x45, 0x02, 0x94, 0xcd, 0x9b, 0x49, 0x52, 0xac, 0x28, 0xd0,
+	0x97, 0x01, 0xda, 0x24, 0xec, 0x07, 0xc7, 0xac, 0x4c, 0x54, 0x7a, 0xe2, 0x32, 0x91, 0xba, 0xe5,
+	0xb7, 0x15, 0x17, 0x6c, 0x70, 0x44, 0x6b, 0x50, 0xf0, 0xda, 0x6c, 0x35, 0x8b, 0x4d, 0x10, 0xb4,
+	0x85, 0xdd, 0x6d, 0x5c, 0xf0, 0xda, 0x46, 0x57, 0xcf, 0xc2, 0xf3, 0xeb, 0xea, 0xb1, 0xff, 0x9e,
+	0x5d, 0x56, 0x7c, 0xfa, 0xfb, 0x32, 0x97, 0xf4, 0x49, 0x58, 0x70, 0x86, 0x49, 0x2f, 0x18, 0x6b,
+	0x6c, 0xdc, 0x64, 0x50, 0x2c, 0xb0, 0x68, 0x0f, 0x4a, 0x6d, 0x1a, 0xe3, 0x15, 0x9e, 0x58, 0x51,
+	0x3a, 0xc6, 0xa3, 0xa1, 0x20, 0xe3, 0x42, 0x6b, 0x64, 0x89, 0xd3, 0x95, 0x15, 0x0d, 0x56, 0x23,
+	0x3b, 0x74, 0x68, 0x0f, 0x14, 0x85, 0x9a, 0x96, 0xa9, 0x74, 0x4a, 0x0f, 0xc4, 0x5f, 0x94, 0x60,
+	0x39, 0x55, 0x7d, 0x4c, 0xed, 0x02, 0xeb, 0xd4, 0x5d, 0x70, 0x11, 0xca, 0x61, 0x34, 0xf4, 0xf9,
+	0xbc, 0xaa, 0xda, 0x30, 0xd0, 0x7d, 0x46, 0x2b, 0xab, 0xf4, 0x0f, 0xd5, 0x51, 0x3b, 0x3a, 0xc6,
+	0x43, 0x5f, 0xe4, 0x95, 0x94, 0x8e, 0xb6, 0x19, 0x14, 0x0b, 0x2c, 0xfa, 0x0a, 0x2c, 0xc5, 0xec,
+	0x00, 0x46, 0x4e, 0x42, 0xba, 0xf2, 0x0b, 0x94, 0xab, 0x73, 0x7f, 0x7b, 0xc0, 0xd9, 0x71, 0xff,
+	0xde, 0x84, 0xe0, 0x94, 0x38, 0xda, 0xe5, 0x67, 0x7c, 0x6f, 0xb1, 0x30, 0x77, 0x0e, 0x34, 0x5b,
+	0xd5, 0xe5, 0xbb, 0xeb, 0xf1, 0x9f, 0x5d, 0x84, 0x6a, 0x67, 0x57, 0x9e, 0xc1, 0xce, 0x86, 0x09,
+	0xbd, 0x6a, 0x9f, 0x86, 0xda, 0xc0, 0xf1, 0xbd, 0x0e, 0x89, 0x13, 0x5a, 0x7f, 0xa0, 0xfb, 0x89,
+	0x7d, 0xc8, 0xbb, 0x2f, 0x81, 0x58, 0xe3, 0xed, 0x77, 0x2d, 0x38, 0x37, 0x71, 0x5a, 0xcf, 0x2d,
+	0x6b, 0x40, 0x2d, 0xd7, 0x8b, 0x13, 0xea, 0xe5, 0x68, 0xf4, 0x6c, 0x3e, 0x96, 0xe1, 0xdc, 0xb9,
+	0x4a, 0x26, 0xae, 0xd8, 0x93, 0x59, 0x4d, 0x6d, 0xb9, 0x8a, 0xcf, 0xd1, 0x72, 0xfd, 0x96, 0x05,
+	0xc6, 0xc7, 0x57, 0xe8, 0x17, 0xa1, 0xe6, 0x0c, 0x93, 0x60, 0xe0, 0x24, 0xa2, 0x5c, 0x3e, 0x7f,
+	0xf7, 0x02, 0xe7, 0xbc, 0x29, 0xb9, 0x72, 0x7d, 0xa9, 0x47, 0xac, 0xe5, 0xd9, 0x3d, 0x78, 0x71,
+	0xc2, 0x0b, 0xda, 0x90, 0x58, 0x8f, 0x31, 0x24, 0xb4, 0x42, 0x4e, 0xfa, 0x1d, 0x7a, 0x61, 0x0a,
+	0x83, 0xa3, 0x2b, 0xe4, 0x02, 0x8e, 0x15, 0x85, 0xfd, 0x9f, 0x62, 0xd6, 0xc2, 0x87, 0xb9, 0x9c,
+	0xe9, 0x20, 0x9b, 0xfd, 0xfa, 0x3f, 0xa6, 0x99, 0x6e, 0xd9, 0x52, 0x9a, 0xc3, 0x17, 0x51, 0xba,
+	0x3f, 0xd5, 0xfc, 0x5e, 0x47, 0xc2, 0xb0, 0x21, 0x2c, 0xb5, 0xbb, 0x8a, 0xa7, 0xed, 0x2e, 0xfb,
+	0xdf, 0x2d, 0x48, 0x19, 0x38, 0x34, 0x80, 0x32, 0x1d, 0xc1, 0x71, 0x0e, 0xdd, 0xaf, 0x26, 0x5f,
+	0xba, 0xf3, 0x44, 0xc1, 0x83, 0xfd, 0xc4, 0x5c, 0x0a, 0xf2, 0x84, 0xeb, 0xc2, 0x55, 0x74, 0x23,
+	0x27, 0x69, 0xd4, 0xf3, 0x69, 0x56, 0xd3, 0x3e, 0x90, 0x7d, 0x19, 0x56, 0xc7, 0x46, 0x44, 0x37,
+	0x11, 0x6b, 0xa8, 0xcb, 0x6e, 0x22, 0xd6, 0x72, 0x87, 0x39, 0xce, 0xfe, 0xa6, 0x05, 0x67, 0xb3,
+	0xec, 0xd1, 0x1f, 0x5a, 0xb0, 0x1a, 0x67, 0xf9, 0x3d, 0x13, 0xad, 0xa9, 0x88, 0x74, 0x0c, 0x85,
+	0xc7, 0x47, 0x40, 0x57, 0x34, 0xdb, 0x9e, 0x9e, 0xaa, 0x2f, 0x5b, 0xa7, 0xd6, 0x97, 0xd3, 0x15,
+	0xd4, 0xc2, 0x4c, 0x15, 0x54, 0xb3, 0xb8, 0x59, 0x7c, 0x6c, 0x71, 0xf3, 0x13, 0x50, 0x39, 0x22,
+	0xc7, 0x46, 0x15, 0x94, 0xff, 0x17, 0x0a, 0x0e, 0xc2, 0x12, 0x47, 0x13, 0x0f, 0xae, 0xc3, 0xa8,
+	0xca, 0x8c, 0x8a, 0x5d, 0x44, 0x5b, 0x9b, 0x8c, 0x48, 0x60, 0x9a, 0x8d, 0xf7, 0x3f, 0x3c, 0xff,
+	0xc2, 0x77, 0x3f, 0x3c, 0xff, 0xc2, 0xf7, 0x3e, 0x3c, 0xff, 0xc2, 0xbb, 0x0f, 0xcf, 0x5b, 0xef,
+	0x3f, 0x3c, 0x6f, 0x7d, 0xf7, 0xe1, 0x79, 0xeb, 0x7b, 0x0f, 0xcf, 0x5b, 0xff, 0xfa, 0xf0, 0xbc,
+	0xf5, 0x7b, 0xdf, 0x3f, 0xff, 0xc2, 0x17, 0xaa, 0x52, 0xb5, 0xff, 0x37, 0x00, 0xa1, 0x31, 0x6d,
+	0x37, 0xd8, 0x4f, 0x00, 0x00,
 }
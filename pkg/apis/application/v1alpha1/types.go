@@ -969,6 +969,10 @@ type ResourceAction struct {
 	Name      string                `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	Params    []ResourceActionParam `json:"params,omitempty" protobuf:"bytes,2,rep,name=params"`
 	Available bool                  `json:"available,omitempty" protobuf:"varint,3,opt,name=available"`
+	// Conditions describe the states of the resource in which the action is intended to be run, e.g. Suspended
+	Conditions []string `json:"conditions,omitempty" protobuf:"bytes,4,rep,name=conditions"`
+	// Inverse is the name of the action undoing this action. Only set if the action is reversible.
+	Inverse string `json:"inverse,omitempty" protobuf:"bytes,5,opt,name=inverse"`
 }
 
 type ResourceActionParam struct {
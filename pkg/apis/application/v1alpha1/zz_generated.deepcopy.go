@@ -1246,6 +1246,11 @@ func (in *ResourceAction) DeepCopyInto(out *ResourceAction) {
 		*out = make([]ResourceActionParam, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
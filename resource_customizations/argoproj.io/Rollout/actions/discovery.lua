@@ -1,5 +1,6 @@
 actions = {}
-actions["resume"] = {["available"] = false}
+actions["pause"] = {["available"] = true, ["inverse"] = "resume"}
+actions["resume"] = {["available"] = false, ["conditions"] = {"Paused"}, ["inverse"] = "pause"}
 
 local paused = false
 
@@ -9,6 +10,7 @@ elseif obj.spec.paused ~= nil then
     paused = obj.spec.paused
 end
 if paused then
+    actions["pause"]["available"] = false
     actions["resume"]["available"] = true
 end
 
@@ -11,6 +11,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apiclient/account"
 	"github.com/argoproj/argo-cd/util/password"
+	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/session"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -19,13 +20,15 @@ import (
 type Server struct {
 	sessionMgr  *session.SessionManager
 	settingsMgr *settings.SettingsManager
+	enf         *rbac.Enforcer
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager) *Server {
+func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer) *Server {
 	return &Server{
 		sessionMgr:  sessionMgr,
 		settingsMgr: settingsMgr,
+		enf:         enf,
 	}
 
 }
@@ -63,3 +66,14 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 	return &account.UpdatePasswordResponse{}, nil
 
 }
+
+// CanI checks if the current user is permitted by the RBAC policy to perform the action on the resource
+func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.CanIResponse, error) {
+	if r.Resource == "" || r.Action == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource and action are required")
+	}
+	if s.enf.Enforce(ctx.Value("claims"), r.Resource, r.Action, r.Subresource) {
+		return &account.CanIResponse{Value: "yes"}, nil
+	}
+	return &account.CanIResponse{Value: "no"}, nil
+}
@@ -10,11 +10,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/errors"
 	"github.com/argoproj/argo-cd/pkg/apiclient/account"
 	sessionpkg "github.com/argoproj/argo-cd/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/server/session"
+	"github.com/argoproj/argo-cd/util/assets"
 	"github.com/argoproj/argo-cd/util/password"
+	"github.com/argoproj/argo-cd/util/rbac"
 	sessionutil "github.com/argoproj/argo-cd/util/session"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -47,7 +50,13 @@ func newTestAccountServer(ctx context.Context) (*fake.Clientset, *Server, *sessi
 	})
 	settingsMgr := settings.NewSettingsManager(ctx, kubeclientset, testNamespace)
 	sessionMgr := sessionutil.NewSessionManager(settingsMgr, "")
-	return kubeclientset, NewServer(sessionMgr, settingsMgr), session.NewServer(sessionMgr, nil)
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	errors.CheckError(enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	errors.CheckError(enforcer.SetUserPolicy("g, admin, role:admin"))
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return enforcer.Enforce(append([]interface{}{claims.(*jwt.StandardClaims).Subject}, rvals[1:]...)...)
+	})
+	return kubeclientset, NewServer(sessionMgr, settingsMgr, enforcer), session.NewServer(sessionMgr, nil)
 }
 
 func TestUpdatePassword(t *testing.T) {
@@ -86,3 +95,20 @@ func TestUpdatePassword(t *testing.T) {
 	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "newpassword"})
 	assert.NoError(t, err)
 }
+
+func TestCanI(t *testing.T) {
+	ctx := context.Background()
+	_, accountServer, _ := newTestAccountServer(ctx)
+	req := &account.CanIRequest{Resource: "applications", Action: "action/apps/Deployment/restart", Subresource: "default/guestbook"}
+
+	res, err := accountServer.CanI(context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin"}), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "yes", res.Value)
+
+	res, err = accountServer.CanI(context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "alice"}), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "no", res.Value)
+
+	_, err = accountServer.CanI(ctx, &account.CanIRequest{Resource: "applications"})
+	assert.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
@@ -21,10 +22,12 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -38,9 +41,11 @@ import (
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/git"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/lua"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/resource"
 	"github.com/argoproj/argo-cd/util/session"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -698,7 +703,7 @@ func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationRe
 		return nil, err
 	}
 
-	manifest, err := s.kubectl.PatchResource(config, res.GroupKindVersion(), res.Name, res.Namespace, types.PatchType(q.PatchType), []byte(q.Patch))
+	manifest, err := s.kubectl.PatchResource(config, res.GroupKindVersion(), res.Name, res.Namespace, types.PatchType(q.PatchType), []byte(q.Patch), "", false)
 	if err != nil {
 		return nil, err
 	}
@@ -1061,7 +1066,12 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 }
 
 func (s *Server) logEvent(a *appv1.Application, ctx context.Context, reason string, action string) {
-	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
+	s.logEventWithAnnotations(a, ctx, reason, action, nil)
+}
+
+// logEventWithAnnotations logs the event with the given annotations set on it
+func (s *Server) logEventWithAnnotations(a *appv1.Application, ctx context.Context, reason string, action string, annotations map[string]string) {
+	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason, Annotations: annotations}
 	user := session.Username(ctx)
 	if user == "" {
 		user = "Unknown user"
@@ -1070,26 +1080,148 @@ func (s *Server) logEvent(a *appv1.Application, ctx context.Context, reason stri
 	s.auditLogger.LogAppEvent(a, eventInfo, message)
 }
 
-func (s *Server) ListResourceActions(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ResourceActionsListResponse, error) {
-	res, config, _, err := s.getAppResource(ctx, rbacpolicy.ActionGet, q)
+// RunResourceActions runs the action on each of the targets and reports the outcome for each of them. A failure to
+// run the action on one target does not prevent it from being run on the other targets.
+func (s *Server) RunResourceActions(ctx context.Context, q *application.ResourceActionsRunRequest) (*application.ResourceActionsRunResponse, error) {
+	res := &application.ResourceActionsRunResponse{Results: []application.ResourceActionRunResult{}}
+	for _, target := range q.Targets {
+		runRes, err := s.RunResourceAction(ctx, &application.ResourceActionRunRequest{
+			Name:              q.Name,
+			Namespace:         target.Namespace,
+			ResourceName:      target.ResourceName,
+			Version:           target.Version,
+			Group:             target.Group,
+			Kind:              target.Kind,
+			Action:            q.Action,
+			Revision:          q.Revision,
+			RunAnnotationKey:  q.RunAnnotationKey,
+			RecordedCommand:   q.RecordedCommand,
+			FieldManager:      q.FieldManager,
+			Reason:            q.Reason,
+			ReturnTargetState: q.ReturnTargetState,
+			DryRun:            q.DryRun,
+			ResourceVersion:   target.ResourceVersion,
+		})
+		result := application.ResourceActionRunResult{Target: target}
+		if err != nil {
+			result.Error = status.Convert(err).Message()
+			result.Reason = application.ActionFailureReason(err)
+		} else {
+			result.ResourceRevision = runRes.ResourceRevision
+			result.Generation = runRes.Generation
+			result.TargetState = runRes.TargetState
+			result.LiveState = runRes.LiveState
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// isApplicationResourceRequest returns true if the request targets the Application resource itself rather than
+// one of the resources managed by the application
+func (s *Server) isApplicationResourceRequest(q *application.ApplicationResourceRequest) bool {
+	gvk := appv1.ApplicationSchemaGroupVersionKind
+	return q.Group == gvk.Group && q.Kind == gvk.Kind && q.ResourceName == *q.Name && (q.Namespace == "" || q.Namespace == s.ns)
+}
+
+// getApplicationResource returns the Application resource itself, both as an unstructured object and as an Application
+func (s *Server) getApplicationResource(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*unstructured.Unstructured, *appv1.Application, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	obj, err := s.kubectl.GetResource(config, res.GroupKindVersion(), res.Name, res.Namespace)
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, appRBACName(*a)); err != nil {
+		return nil, nil, err
+	}
+	obj, err := kube.ToUnstructured(a)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	obj.SetGroupVersionKind(appv1.ApplicationSchemaGroupVersionKind)
+	return obj, a, nil
+}
+
+func (s *Server) ListResourceActions(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ResourceActionsListResponse, error) {
+	var obj *unstructured.Unstructured
+	if s.isApplicationResourceRequest(q) {
+		appObj, _, err := s.getApplicationResource(ctx, rbacpolicy.ActionGet, q)
+		if err != nil {
+			return nil, err
+		}
+		obj = appObj
+	} else {
+		res, config, _, err := s.getAppResource(ctx, rbacpolicy.ActionGet, q)
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				// the resource is not part of the application
+				return nil, actionFailure(application.ResourceActionFailureReason_NotFound, err)
+			}
+			return nil, err
+		}
+		obj, err = s.kubectl.GetResource(config, res.GroupKindVersion(), res.Name, res.Namespace)
+		if err != nil {
+			return nil, err
+		}
 	}
 	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return nil, err
 	}
 
-	availableActions, err := s.getAvailableActions(resourceOverrides, obj, res.GroupKindVersion(), "")
+	availableActions, err := s.getAvailableActions(resourceOverrides, obj, obj.GroupVersionKind(), "")
 	if err != nil {
 		return nil, err
 	}
 
-	return &application.ResourceActionsListResponse{Actions: availableActions}, nil
+	res := &application.ResourceActionsListResponse{Actions: availableActions}
+	if len(availableActions) == 0 {
+		// let clients skip the other resources of the kind if there is no discovery script for it at all
+		luaVM := lua.VM{
+			ResourceOverrides: resourceOverrides,
+		}
+		discoveryScript, err := luaVM.GetResourceActionDiscovery(obj)
+		if err != nil {
+			return nil, err
+		}
+		res.NoActionsForKind = discoveryScript == ""
+	}
+	return res, nil
+}
+
+// ListResourceActionsBatch lists the actions of each of the targets. The other targets of a kind which has no actions
+// at all are not looked up, and are reported with noActionsForKind set. As with RunResourceActions, a target whose
+// actions cannot be listed, e.g. since it was deleted, is reported with the error rather than failing the call.
+func (s *Server) ListResourceActionsBatch(ctx context.Context, q *application.ResourceActionsListBatchRequest) (*application.ResourceActionsListBatchResponse, error) {
+	res := &application.ResourceActionsListBatchResponse{Results: []application.ResourceActionsListResult{}}
+	noActionKinds := make(map[schema.GroupKind]bool)
+	for _, target := range q.Targets {
+		gk := schema.GroupKind{Group: target.Group, Kind: target.Kind}
+		result := application.ResourceActionsListResult{Target: target, Actions: []appv1.ResourceAction{}}
+		if noActionKinds[gk] {
+			result.NoActionsForKind = true
+			res.Results = append(res.Results, result)
+			continue
+		}
+		listRes, err := s.ListResourceActions(ctx, &application.ApplicationResourceRequest{
+			Name:         q.Name,
+			Namespace:    target.Namespace,
+			ResourceName: target.ResourceName,
+			Version:      target.Version,
+			Group:        target.Group,
+			Kind:         target.Kind,
+		})
+		if err != nil {
+			result.Error = status.Convert(err).Message()
+			result.Reason = application.ActionFailureReason(withActionFailureReason(err))
+			res.Results = append(res.Results, result)
+			continue
+		}
+		noActionKinds[gk] = listRes.NoActionsForKind
+		result.Actions = listRes.Actions
+		result.NoActionsForKind = listRes.NoActionsForKind
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
 }
 
 func (s *Server) getAvailableActions(resourceOverrides map[string]appv1.ResourceOverride, obj *unstructured.Unstructured, gvk schema.GroupVersionKind, filterAction string) ([]appv1.ResourceAction, error) {
@@ -1119,7 +1251,15 @@ func (s *Server) getAvailableActions(resourceOverrides map[string]appv1.Resource
 
 }
 
-func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceActionRunRequest) (*application.ApplicationResponse, error) {
+func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceActionRunRequest) (*application.ResourceActionRunResponse, error) {
+	res, err := s.runResourceAction(ctx, q)
+	if err != nil {
+		return nil, withActionFailureReason(err)
+	}
+	return res, nil
+}
+
+func (s *Server) runResourceAction(ctx context.Context, q *application.ResourceActionRunRequest) (*application.ResourceActionRunResponse, error) {
 	resourceRequest := &application.ApplicationResourceRequest{
 		Name:         q.Name,
 		Namespace:    q.Namespace,
@@ -1128,15 +1268,51 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		Version:      q.Version,
 		Group:        q.Group,
 	}
+	if q.RunAnnotationKey != "" {
+		if errs := validation.IsQualifiedName(q.RunAnnotationKey); len(errs) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid run annotation key '%s': %s", q.RunAnnotationKey, strings.Join(errs, ", "))
+		}
+	}
+	// dry runs do not change anything, so they do not need a reason
+	if q.Reason == "" && !q.DryRun {
+		requireReason, err := s.settingsMgr.GetActionsRequireReason()
+		if err != nil {
+			return nil, err
+		}
+		if requireReason {
+			return nil, status.Errorf(codes.InvalidArgument, "a reason is required to run actions. Please give the reason for running action '%s'", q.Action)
+		}
+	}
 	actionRequest := fmt.Sprintf("%s/%s/%s/%s", rbacpolicy.ActionAction, q.Group, q.Kind, q.Action)
-	res, config, _, err := s.getAppResource(ctx, actionRequest, resourceRequest)
+	if s.isApplicationResourceRequest(resourceRequest) {
+		return s.runApplicationAction(ctx, actionRequest, q, resourceRequest)
+	}
+	res, config, a, err := s.getAppResource(ctx, actionRequest, resourceRequest)
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			// the resource is not part of the application
+			return nil, actionFailure(application.ResourceActionFailureReason_NotFound, err)
+		}
 		return nil, err
 	}
 	liveObj, err := s.kubectl.GetResource(config, res.GroupKindVersion(), res.Name, res.Namespace)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkResourceVersion(liveObj, q.ResourceVersion); err != nil {
+		return nil, err
+	}
+
+	// The action is evaluated against sourceObj. By default this is the live object, but the resource definition
+	// at a specific revision can be used instead, in which case only the changes made by the action are applied
+	// to the live object.
+	sourceObj := liveObj
+	if q.Revision != "" {
+		sourceObj, err = s.getRevisionResource(ctx, a, res, q.Revision)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
 	if err != nil {
@@ -1150,11 +1326,171 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	if err != nil {
 		return nil, err
 	}
+	if action.ActionLua == "" {
+		return nil, actionFailure(application.ResourceActionFailureReason_Unavailable, status.Errorf(codes.NotFound, "action '%s' is not defined for %s %s", q.Action, liveObj.GetKind(), liveObj.GetName()))
+	}
 
-	newObj, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua)
+	newObj, err := luaVM.ExecuteResourceAction(sourceObj, action.ActionLua)
+	if err != nil {
+		return nil, actionFailure(application.ResourceActionFailureReason_LuaError, actionScriptError(q.Action, err))
+	}
+	if q.RunAnnotationKey != "" {
+		if err := setActionRunAnnotation(ctx, newObj, q.RunAnnotationKey, q.Action, q.Reason); err != nil {
+			return nil, err
+		}
+	}
+
+	newObjBytes, err := json.Marshal(newObj)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceObjBytes, err := json.Marshal(sourceObj)
+	if err != nil {
+		return nil, err
+	}
+
+	diffBytes, err := jsonpatch.CreateMergePatch(sourceObjBytes, newObjBytes)
+	if err != nil {
+		return nil, err
+	}
+	if string(diffBytes) == "{}" {
+		if q.DryRun {
+			return newDryRunActionResponse(liveObj, liveObj)
+		}
+		s.recordActionRun(ctx, a, q)
+		return withTargetState(newActionRunResponse(liveObj), liveObj, q.ReturnTargetState)
+	}
+
+	fieldManager := q.FieldManager
+	if fieldManager == "" {
+		fieldManager = common.ArgoCDActionsFieldManager
+	}
+	if q.ResourceVersion != "" {
+		// the API server rejects the patch if the resource was modified after it was read
+		if diffBytes, err = withResourceVersion(diffBytes, q.ResourceVersion); err != nil {
+			return nil, err
+		}
+	}
+	patchedObj, err := s.kubectl.PatchResource(config, liveObj.GroupVersionKind(), liveObj.GetName(), liveObj.GetNamespace(), types.MergePatchType, diffBytes, fieldManager, q.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	if q.DryRun {
+		return newDryRunActionResponse(liveObj, patchedObj)
+	}
+	s.recordActionRun(ctx, a, q)
+	return withTargetState(newActionRunResponse(patchedObj), patchedObj, q.ReturnTargetState)
+}
+
+// withTargetState sets the JSON of obj, the resource after the action was run, as the target state of the response if
+// requested
+func withTargetState(res *application.ResourceActionRunResponse, obj interface{}, requested bool) (*application.ResourceActionRunResponse, error) {
+	if !requested {
+		return res, nil
+	}
+	targetState, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	res.TargetState = string(targetState)
+	return res, nil
+}
+
+// newDryRunActionResponse returns the response of a dry run, which includes the live state of the resource and the
+// state the action would result in
+func newDryRunActionResponse(liveObj *unstructured.Unstructured, targetObj *unstructured.Unstructured) (*application.ResourceActionRunResponse, error) {
+	res := newActionRunResponse(targetObj)
+	liveState, err := json.Marshal(liveObj)
+	if err != nil {
+		return nil, err
+	}
+	res.LiveState = string(liveState)
+	if targetObj != nil {
+		targetState, err := json.Marshal(targetObj)
+		if err != nil {
+			return nil, err
+		}
+		res.TargetState = string(targetState)
+	}
+	return res, nil
+}
+
+// newActionRunResponse returns the response describing the revision and generation of the resource after the action
+// was run on it
+func newActionRunResponse(obj *unstructured.Unstructured) *application.ResourceActionRunResponse {
+	if obj == nil {
+		return &application.ResourceActionRunResponse{}
+	}
+	return &application.ResourceActionRunResponse{
+		ResourceRevision: resource.GetRevision(obj),
+		Generation:       obj.GetGeneration(),
+	}
+}
+
+// applicationActionProtectedFields are the fields of the spec of an Application which actions may not change, since
+// the patch of an action bypasses the validation of the application against the restrictions of its project
+var applicationActionProtectedFields = []string{"project", "destination", "source"}
+
+// checkApplicationActionPatch returns an error if the merge patch of the action changes any of the
+// applicationActionProtectedFields of the application
+func checkApplicationActionPatch(action string, appName string, patch []byte) error {
+	var diff struct {
+		Spec map[string]json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(patch, &diff); err != nil {
+		return err
+	}
+	for _, field := range applicationActionProtectedFields {
+		if _, ok := diff.Spec[field]; ok {
+			return actionFailure(application.ResourceActionFailureReason_PreconditionFailed, status.Errorf(codes.InvalidArgument, "action '%s' may not change spec.%s of the application %s. Please update the application instead", action, field, appName))
+		}
+	}
+	return nil
+}
+
+// runApplicationAction runs the requested action against the Application resource itself. Unlike actions on managed
+// resources, the resulting patch is applied to the Application in the Argo CD namespace rather than in the
+// destination cluster.
+func (s *Server) runApplicationAction(ctx context.Context, actionRequest string, q *application.ResourceActionRunRequest, resourceRequest *application.ApplicationResourceRequest) (*application.ResourceActionRunResponse, error) {
+	if q.Revision != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "revision is not supported for actions on the application %s itself", *q.Name)
+	}
+	if q.DryRun {
+		return nil, status.Errorf(codes.InvalidArgument, "dry run is not supported for actions on the application %s itself", *q.Name)
+	}
+	liveObj, a, err := s.getApplicationResource(ctx, actionRequest, resourceRequest)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResourceVersion(liveObj, q.ResourceVersion); err != nil {
+		return nil, err
+	}
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, err
+	}
+
+	luaVM := lua.VM{
+		ResourceOverrides: resourceOverrides,
+	}
+	action, err := luaVM.GetResourceAction(liveObj, q.Action)
 	if err != nil {
 		return nil, err
 	}
+	if action.ActionLua == "" {
+		return nil, actionFailure(application.ResourceActionFailureReason_Unavailable, status.Errorf(codes.NotFound, "action '%s' is not defined for %s %s", q.Action, liveObj.GetKind(), liveObj.GetName()))
+	}
+
+	newObj, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua)
+	if err != nil {
+		return nil, actionFailure(application.ResourceActionFailureReason_LuaError, actionScriptError(q.Action, err))
+	}
+	if q.RunAnnotationKey != "" {
+		if err := setActionRunAnnotation(ctx, newObj, q.RunAnnotationKey, q.Action, q.Reason); err != nil {
+			return nil, err
+		}
+	}
 
 	newObjBytes, err := json.Marshal(newObj)
 	if err != nil {
@@ -1171,14 +1507,170 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		return nil, err
 	}
 	if string(diffBytes) == "{}" {
-		return &application.ApplicationResponse{}, nil
+		s.recordActionRun(ctx, a, q)
+		return withTargetState(newActionRunResponse(liveObj), liveObj, q.ReturnTargetState)
+	}
+	if err := checkApplicationActionPatch(q.Action, a.Name, diffBytes); err != nil {
+		return nil, err
 	}
 
-	_, err = s.kubectl.PatchResource(config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes)
+	if q.ResourceVersion != "" {
+		if diffBytes, err = withResourceVersion(diffBytes, q.ResourceVersion); err != nil {
+			return nil, err
+		}
+	}
+	patchedApp, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Patch(liveObj.GetName(), types.MergePatchType, diffBytes)
 	if err != nil {
 		return nil, err
 	}
-	return &application.ApplicationResponse{}, nil
+	s.recordActionRun(ctx, a, q)
+	// the typed Application returned by the client does not include its type
+	gvk := appv1.ApplicationSchemaGroupVersionKind
+	patchedApp.APIVersion, patchedApp.Kind = gvk.GroupVersion().String(), gvk.Kind
+	return withTargetState(&application.ResourceActionRunResponse{Generation: patchedApp.Generation}, patchedApp, q.ReturnTargetState)
+}
+
+// recordActionRun records the action run in the event history of the application if the request includes the
+// command line which ran it or the reason for running it. The reason is also recorded in an annotation of the event.
+func (s *Server) recordActionRun(ctx context.Context, a *appv1.Application, q *application.ResourceActionRunRequest) {
+	if q.RecordedCommand == "" && q.Reason == "" {
+		return
+	}
+	message := fmt.Sprintf("ran action %s on resource %s/%s '%s'", q.Action, q.Group, q.Kind, q.ResourceName)
+	if q.RecordedCommand != "" {
+		message += ": " + q.RecordedCommand
+	}
+	var annotations map[string]string
+	if q.Reason != "" {
+		message += fmt.Sprintf(" (reason: %s)", q.Reason)
+		annotations = map[string]string{common.AnnotationKeyActionReason: q.Reason}
+	}
+	s.logEventWithAnnotations(a, ctx, argo.EventReasonResourceActionRan, message, annotations)
+}
+
+// actionScriptError returns the error to report if the script of the given action failed to run. The message includes
+// the line at which the Lua error was raised and the Lua stack trace is attached as debug info.
+func actionScriptError(action string, err error) error {
+	scriptErr, ok := err.(*lua.ScriptError)
+	if !ok {
+		return err
+	}
+	st := status.Newf(codes.Internal, "error running action '%s': %s", action, scriptErr.Message)
+	if len(scriptErr.StackTrace) > 0 {
+		if withDetails, detailsErr := st.WithDetails(&errdetails.DebugInfo{StackEntries: scriptErr.StackTrace}); detailsErr == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
+
+// actionFailure returns the error with the reason why the action failed attached, so that clients can tell failures
+// apart without matching error messages
+func actionFailure(reason application.ResourceActionFailureReason, err error) error {
+	st := status.Convert(grpc_util.KubeErrToGRPC(err))
+	if withDetails, detailsErr := st.WithDetails(&application.ResourceActionFailure{Reason: reason}); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// checkResourceVersion returns a conflict error if the resource version of obj is not the expected one. Any resource
+// version is accepted if none is expected.
+func checkResourceVersion(obj *unstructured.Unstructured, expected string) error {
+	if expected == "" || obj.GetResourceVersion() == expected {
+		return nil
+	}
+	return actionFailure(application.ResourceActionFailureReason_PreconditionFailed, status.Errorf(codes.Aborted, "%s %s/%s is at resource version %s, but resource version %s was expected", obj.GetKind(), obj.GetNamespace(), obj.GetName(), obj.GetResourceVersion(), expected))
+}
+
+// withResourceVersion adds the resource version to the merge patch, so that the patch is only applied to the resource
+// at that version
+func withResourceVersion(patch []byte, resourceVersion string) ([]byte, error) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(patch, &obj); err != nil {
+		return nil, err
+	}
+	if err := unstructured.SetNestedField(obj, resourceVersion, "metadata", "resourceVersion"); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// withActionFailureReason attaches the reason derived from its code to an error of a failed action unless a reason
+// was already attached where the error occurred
+func withActionFailureReason(err error) error {
+	if application.ActionFailureReason(err) != application.ResourceActionFailureReason_Unknown {
+		return err
+	}
+	reason := application.ResourceActionFailureReason_Unknown
+	switch {
+	case apierr.IsConflict(err):
+		reason = application.ResourceActionFailureReason_PreconditionFailed
+	default:
+		switch status.Code(grpc_util.KubeErrToGRPC(err)) {
+		case codes.NotFound:
+			reason = application.ResourceActionFailureReason_NotFound
+		case codes.PermissionDenied:
+			reason = application.ResourceActionFailureReason_Forbidden
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			reason = application.ResourceActionFailureReason_PreconditionFailed
+		}
+	}
+	return actionFailure(reason, err)
+}
+
+// actionRunAnnotation is the value of the annotation recording an action run
+type actionRunAnnotation struct {
+	Action    string `json:"action"`
+	User      string `json:"user"`
+	Timestamp string `json:"timestamp"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// setActionRunAnnotation records the action, the user running it, the reason given for it and the current time in the
+// given annotation of obj
+func setActionRunAnnotation(ctx context.Context, obj *unstructured.Unstructured, key string, action string, reason string) error {
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	value, err := json.Marshal(actionRunAnnotation{Action: action, User: user, Timestamp: time.Now().UTC().Format(time.RFC3339), Reason: reason})
+	if err != nil {
+		return err
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[key] = string(value)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// getRevisionResource returns the definition of the given application resource as rendered from the given revision
+func (s *Server) getRevisionResource(ctx context.Context, a *appv1.Application, res *appv1.ResourceNode, revision string) (*unstructured.Unstructured, error) {
+	manifests, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{Name: &a.Name, Revision: revision})
+	if err != nil {
+		return nil, err
+	}
+	for _, manifest := range manifests.Manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, err
+		}
+		gvk := obj.GroupVersionKind()
+		if gvk.Group != res.Group || gvk.Kind != res.Kind || obj.GetName() != res.Name {
+			continue
+		}
+		namespace := obj.GetNamespace()
+		if namespace == "" && res.Namespace != "" {
+			namespace = a.Spec.Destination.Namespace
+		}
+		if namespace == res.Namespace {
+			return obj, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "%s %s %s not found in revision %s of application %s", res.Kind, res.Group, res.Name, revision, a.Name)
 }
 
 func (s *Server) plugins() ([]*v1alpha1.ConfigManagementPlugin, error) {
@@ -2,19 +2,28 @@ package application
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-cd/common"
@@ -29,9 +38,12 @@ import (
 	"github.com/argoproj/argo-cd/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/kube/kubetest"
+	"github.com/argoproj/argo-cd/util/lua"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/settings"
 )
@@ -450,3 +462,381 @@ func TestServer_GetApplicationMaintenanceState(t *testing.T) {
 	})
 
 }
+
+func TestSetActionRunAnnotation(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "guestbook", "annotations": map[string]interface{}{"foo": "bar"}},
+	}}
+	assert.NoError(t, setActionRunAnnotation(context.Background(), obj, "example.com/last-run", "restart", ""))
+
+	annotations := obj.GetAnnotations()
+	assert.Equal(t, "bar", annotations["foo"])
+	var run actionRunAnnotation
+	assert.NoError(t, json.Unmarshal([]byte(annotations["example.com/last-run"]), &run))
+	assert.Equal(t, "restart", run.Action)
+	assert.Equal(t, "Unknown user", run.User)
+	_, err := time.Parse(time.RFC3339, run.Timestamp)
+	assert.NoError(t, err)
+}
+
+func TestRunResourceActionInvalidRunAnnotationKey(t *testing.T) {
+	appServer := newTestAppServer()
+	appName := "guestbook"
+	_, err := appServer.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{Name: &appName, Action: "restart", RunAnnotationKey: "not a key"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, application.ResourceActionFailureReason_PreconditionFailed, application.ActionFailureReason(err))
+}
+
+func TestRunResourceActionUndefinedAction(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	appName := "test-app"
+	gvk := appsv1.ApplicationSchemaGroupVersionKind
+	_, err := appServer.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{Name: &appName, Group: gvk.Group, Kind: gvk.Kind, ResourceName: appName, Action: "undefined"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "action 'undefined' is not defined for Application test-app", status.Convert(err).Message())
+	assert.Equal(t, application.ResourceActionFailureReason_Unavailable, application.ActionFailureReason(err))
+}
+
+func TestCheckResourceVersion(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "Deployment",
+		"metadata": map[string]interface{}{"name": "guestbook-ui", "namespace": "default", "resourceVersion": "123"},
+	}}
+	assert.NoError(t, checkResourceVersion(obj, ""))
+	assert.NoError(t, checkResourceVersion(obj, "123"))
+	err := checkResourceVersion(obj, "122")
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Equal(t, "Deployment default/guestbook-ui is at resource version 123, but resource version 122 was expected", status.Convert(err).Message())
+	assert.Equal(t, application.ResourceActionFailureReason_PreconditionFailed, application.ActionFailureReason(err))
+}
+
+func TestWithTargetState(t *testing.T) {
+	obj := map[string]interface{}{"kind": "Deployment"}
+	res, err := withTargetState(&application.ResourceActionRunResponse{}, obj, false)
+	assert.NoError(t, err)
+	assert.Empty(t, res.TargetState)
+	res, err = withTargetState(&application.ResourceActionRunResponse{}, obj, true)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"Deployment"}`, res.TargetState)
+}
+
+func TestRunApplicationActionResourceVersionConflict(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	appName := "test-app"
+	gvk := appsv1.ApplicationSchemaGroupVersionKind
+	_, err := appServer.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{Name: &appName, Group: gvk.Group, Kind: gvk.Kind, ResourceName: appName, Action: "refresh", ResourceVersion: "999"})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "but resource version 999 was expected")
+}
+
+func TestRunApplicationActionProtectedFields(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	appServer.settingsMgr = settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{"resource.customizations": `
+    argoproj.io/Application:
+      actions: |
+        definitions:
+        - name: move
+          action.lua: |
+            obj.spec.project = "other"
+            return obj
+        - name: retarget
+          action.lua: |
+            obj.spec.destination.namespace = "kube-system"
+            return obj`},
+	}), testNamespace)
+	appName := "test-app"
+	gvk := appsv1.ApplicationSchemaGroupVersionKind
+	for action, field := range map[string]string{"move": "project", "retarget": "destination"} {
+		_, err := appServer.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{Name: &appName, Group: gvk.Group, Kind: gvk.Kind, ResourceName: appName, Action: action})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, fmt.Sprintf("action '%s' may not change spec.%s of the application test-app. Please update the application instead", action, field), status.Convert(err).Message())
+		assert.Equal(t, application.ResourceActionFailureReason_PreconditionFailed, application.ActionFailureReason(err))
+	}
+	app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(appName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, newTestApp().Spec, app.Spec)
+}
+
+func TestCheckApplicationActionPatch(t *testing.T) {
+	assert.NoError(t, checkApplicationActionPatch("refresh", "guestbook", []byte(`{"metadata":{"annotations":{"argocd.argoproj.io/refresh":"normal"}}}`)))
+	assert.NoError(t, checkApplicationActionPatch("sync-policy", "guestbook", []byte(`{"spec":{"syncPolicy":{"automated":null}}}`)))
+	assert.Error(t, checkApplicationActionPatch("retarget", "guestbook", []byte(`{"spec":{"source":{"targetRevision":"master"}}}`)))
+}
+
+func TestWithResourceVersion(t *testing.T) {
+	patch, err := withResourceVersion([]byte(`{"spec":{"paused":false}}`), "123")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"metadata":{"resourceVersion":"123"},"spec":{"paused":false}}`, string(patch))
+	patch, err = withResourceVersion([]byte(`{"metadata":{"annotations":{"foo":"bar"}}}`), "123")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"metadata":{"annotations":{"foo":"bar"},"resourceVersion":"123"}}`, string(patch))
+}
+
+func TestWithActionFailureReason(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		reason application.ResourceActionFailureReason
+	}{
+		{status.Errorf(codes.PermissionDenied, "permission denied"), application.ResourceActionFailureReason_Forbidden},
+		{apierr.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "guestbook"), application.ResourceActionFailureReason_NotFound},
+		{apierr.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "guestbook", fmt.Errorf("modified")), application.ResourceActionFailureReason_PreconditionFailed},
+		{fmt.Errorf("connection refused"), application.ResourceActionFailureReason_Unknown},
+		// reasons attached where the error occurred are kept
+		{actionFailure(application.ResourceActionFailureReason_LuaError, status.Errorf(codes.Internal, "script failed")), application.ResourceActionFailureReason_LuaError},
+	} {
+		err := withActionFailureReason(tc.err)
+		assert.Equal(t, tc.reason, application.ActionFailureReason(err), tc.err.Error())
+	}
+	// the code of Kubernetes errors is kept
+	assert.Equal(t, codes.NotFound, status.Code(withActionFailureReason(apierr.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "config"))))
+}
+
+func TestNewActionRunResponse(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata": map[string]interface{}{
+			"name":        "guestbook",
+			"generation":  int64(7),
+			"annotations": map[string]interface{}{"rollout.argoproj.io/revision": "3"},
+		},
+	}}
+	assert.Equal(t, &application.ResourceActionRunResponse{ResourceRevision: 3, Generation: 7}, newActionRunResponse(obj))
+	assert.Equal(t, &application.ResourceActionRunResponse{}, newActionRunResponse(nil))
+}
+
+func TestNewDryRunActionResponse(t *testing.T) {
+	liveObj := &unstructured.Unstructured{Object: map[string]interface{}{"kind": "Deployment", "spec": map[string]interface{}{"paused": false}}}
+	targetObj := &unstructured.Unstructured{Object: map[string]interface{}{"kind": "Deployment", "spec": map[string]interface{}{"paused": true}}}
+	res, err := newDryRunActionResponse(liveObj, targetObj)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"kind":"Deployment","spec":{"paused":false}}`, res.LiveState)
+	assert.Equal(t, `{"kind":"Deployment","spec":{"paused":true}}`, res.TargetState)
+}
+
+func TestRunApplicationActionDryRun(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	appName := "test-app"
+	gvk := appsv1.ApplicationSchemaGroupVersionKind
+	_, err := appServer.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{Name: &appName, Group: gvk.Group, Kind: gvk.Kind, ResourceName: appName, Action: "refresh", DryRun: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestActionScriptError(t *testing.T) {
+	err := actionScriptError("restart", &lua.ScriptError{
+		Message:    "<string>:3: attempt to index a non-table object(nil)",
+		StackTrace: []string{"<string>:3: in main chunk", "[G]: ?"},
+	})
+	st := status.Convert(err)
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, "error running action 'restart': <string>:3: attempt to index a non-table object(nil)", st.Message())
+	if assert.Len(t, st.Details(), 1) {
+		assert.Equal(t, []string{"<string>:3: in main chunk", "[G]: ?"}, st.Details()[0].(*errdetails.DebugInfo).StackEntries)
+	}
+
+	// other errors are returned as is
+	otherErr := status.Errorf(codes.NotFound, "not found")
+	assert.Equal(t, otherErr, actionScriptError("restart", otherErr))
+}
+
+func TestListResourceActionsNoActionsForKind(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	appName := "test-app"
+	gvk := appsv1.ApplicationSchemaGroupVersionKind
+	res, err := appServer.ListResourceActions(context.Background(), &application.ApplicationResourceRequest{Name: &appName, Group: gvk.Group, Kind: gvk.Kind, ResourceName: appName})
+	if assert.NoError(t, err) {
+		assert.Empty(t, res.Actions)
+		assert.True(t, res.NoActionsForKind)
+	}
+}
+
+// patchRecordingKubectl is a kubectl serving a single live resource, which records the patches applied to it
+type patchRecordingKubectl struct {
+	kubetest.MockKubectlCmd
+	live    *unstructured.Unstructured
+	dryRuns []bool
+}
+
+func (k *patchRecordingKubectl) GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	return k.live.DeepCopy(), nil
+}
+
+func (k *patchRecordingKubectl) PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte, fieldManager string, dryRun bool) (*unstructured.Unstructured, error) {
+	k.dryRuns = append(k.dryRuns, dryRun)
+	liveBytes, err := json.Marshal(k.live)
+	if err != nil {
+		return nil, err
+	}
+	patchedBytes, err := jsonpatch.MergePatch(liveBytes, patchBytes)
+	if err != nil {
+		return nil, err
+	}
+	patched := &unstructured.Unstructured{}
+	return patched, json.Unmarshal(patchedBytes, patched)
+}
+
+func TestRunResourceActionsDryRun(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	kubectl := &patchRecordingKubectl{live: &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata":   map[string]interface{}{"name": "guestbook", "namespace": test.FakeDestNamespace, "resourceVersion": "123"},
+		"spec":       map[string]interface{}{"paused": true},
+		"status":     map[string]interface{}{},
+	}}}
+	appServer.kubectl = kubectl
+	appServer.cache = cache.NewCache(cache.NewInMemoryCache(time.Hour))
+	assert.NoError(t, appServer.cache.SetAppResourcesTree("test-app", &appsv1.ApplicationTree{Nodes: []appsv1.ResourceNode{{
+		ResourceRef: appsv1.ResourceRef{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout", Namespace: test.FakeDestNamespace, Name: "guestbook"},
+	}}}))
+	appName := "test-app"
+	target := application.ResourceActionTarget{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout", Namespace: test.FakeDestNamespace, ResourceName: "guestbook"}
+	staleTarget := target
+	staleTarget.ResourceVersion = "122"
+	res, err := appServer.RunResourceActions(context.Background(), &application.ResourceActionsRunRequest{
+		Name:    &appName,
+		Action:  "resume",
+		DryRun:  true,
+		Targets: []application.ResourceActionTarget{target, staleTarget},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Results, 2) {
+		assert.Empty(t, res.Results[0].Error)
+		assert.Contains(t, res.Results[0].LiveState, `"paused":true`)
+		assert.Contains(t, res.Results[0].TargetState, `"paused":false`)
+		// the resource version of each target is checked
+		assert.Contains(t, res.Results[1].Error, "but resource version 122 was expected")
+		assert.Equal(t, application.ResourceActionFailureReason_PreconditionFailed, res.Results[1].Reason)
+	}
+	// the changes were only submitted as a dry run
+	assert.Equal(t, []bool{true}, kubectl.dryRuns)
+}
+
+func TestListResourceActionsBatch(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	appName := "test-app"
+	gvk := appsv1.ApplicationSchemaGroupVersionKind
+	target := application.ResourceActionTarget{Group: gvk.Group, Kind: gvk.Kind, ResourceName: appName}
+	res, err := appServer.ListResourceActionsBatch(context.Background(), &application.ResourceActionsListBatchRequest{
+		Name:    &appName,
+		Targets: []application.ResourceActionTarget{target, target},
+	})
+	if assert.NoError(t, err) && assert.Len(t, res.Results, 2) {
+		for _, result := range res.Results {
+			assert.Equal(t, target, result.Target)
+			assert.Empty(t, result.Actions)
+			assert.True(t, result.NoActionsForKind)
+		}
+	}
+
+	// a target which cannot be looked up is reported with the error, without failing the other targets
+	appServer.cache = cache.NewCache(cache.NewInMemoryCache(time.Hour))
+	assert.NoError(t, appServer.cache.SetAppResourcesTree(appName, &appsv1.ApplicationTree{}))
+	missing := application.ResourceActionTarget{Group: "apps", Kind: "Deployment", Namespace: "default", ResourceName: "deleted"}
+	res, err = appServer.ListResourceActionsBatch(context.Background(), &application.ResourceActionsListBatchRequest{
+		Name:    &appName,
+		Targets: []application.ResourceActionTarget{missing, target},
+	})
+	if assert.NoError(t, err) && assert.Len(t, res.Results, 2) {
+		assert.Equal(t, missing, res.Results[0].Target)
+		assert.NotEmpty(t, res.Results[0].Error)
+		assert.Equal(t, application.ResourceActionFailureReason_NotFound, res.Results[0].Reason)
+		assert.Empty(t, res.Results[1].Error)
+		assert.True(t, res.Results[1].NoActionsForKind)
+	}
+}
+
+func TestRecordActionRun(t *testing.T) {
+	appServer := newTestAppServer()
+	app := newTestApp()
+	appName := app.Name
+	q := &application.ResourceActionRunRequest{Name: &appName, Group: "apps", Kind: "Deployment", ResourceName: "guestbook-ui", Action: "restart"}
+
+	// action runs are only recorded on request
+	appServer.recordActionRun(context.Background(), app, q)
+	events, err := appServer.kubeclientset.CoreV1().Events(testNamespace).List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, events.Items)
+
+	q.RecordedCommand = "argocd app actions run test-app restart --kind Deployment"
+	appServer.recordActionRun(context.Background(), app, q)
+	events, err = appServer.kubeclientset.CoreV1().Events(testNamespace).List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, events.Items, 1) {
+		assert.Equal(t, argo.EventReasonResourceActionRan, events.Items[0].Reason)
+		assert.Equal(t, "test-app", events.Items[0].InvolvedObject.Name)
+		assert.Equal(t, "Unknown user ran action restart on resource apps/Deployment 'guestbook-ui': argocd app actions run test-app restart --kind Deployment", events.Items[0].Message)
+		assert.Empty(t, events.Items[0].Annotations)
+	}
+}
+
+func TestRecordActionRunReason(t *testing.T) {
+	appServer := newTestAppServer()
+	app := newTestApp()
+	appName := app.Name
+	q := &application.ResourceActionRunRequest{Name: &appName, Group: "apps", Kind: "Deployment", ResourceName: "guestbook-ui", Action: "restart", Reason: "INC-1234"}
+
+	// action runs with a reason are recorded even without the command line
+	appServer.recordActionRun(context.Background(), app, q)
+	events, err := appServer.kubeclientset.CoreV1().Events(testNamespace).List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, events.Items, 1) {
+		assert.Equal(t, "Unknown user ran action restart on resource apps/Deployment 'guestbook-ui' (reason: INC-1234)", events.Items[0].Message)
+		assert.Equal(t, map[string]string{common.AnnotationKeyActionReason: "INC-1234"}, events.Items[0].Annotations)
+	}
+}
+
+func TestRunResourceActionRequiresReason(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	appServer.settingsMgr = settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{"resource.actions.requireReason": "true"},
+	}), testNamespace)
+	appName := "test-app"
+	gvk := appsv1.ApplicationSchemaGroupVersionKind
+	_, err := appServer.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{Name: &appName, Group: gvk.Group, Kind: gvk.Kind, ResourceName: appName, Action: "refresh"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "a reason is required to run actions. Please give the reason for running action 'refresh'", status.Convert(err).Message())
+	assert.Equal(t, application.ResourceActionFailureReason_PreconditionFailed, application.ActionFailureReason(err))
+
+	// with a reason, the action is run
+	_, err = appServer.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{Name: &appName, Group: gvk.Group, Kind: gvk.Kind, ResourceName: appName, Action: "undefined", Reason: "INC-1234"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRunResourceActionsReportsEachTarget(t *testing.T) {
+	appServer := newTestAppServer()
+	appName := "guestbook"
+	res, err := appServer.RunResourceActions(context.Background(), &application.ResourceActionsRunRequest{
+		Name:   &appName,
+		Action: "restart",
+		Targets: []application.ResourceActionTarget{
+			{Group: "apps", Kind: "Deployment", Namespace: "default", ResourceName: "a"},
+			{Group: "apps", Kind: "Deployment", Namespace: "default", ResourceName: "b"},
+		},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Results, 2) {
+		assert.Equal(t, "a", res.Results[0].Target.ResourceName)
+		assert.Contains(t, res.Results[0].Error, "not found")
+		assert.Equal(t, application.ResourceActionFailureReason_NotFound, res.Results[0].Reason)
+		assert.Equal(t, "b", res.Results[1].Target.ResourceName)
+		assert.Contains(t, res.Results[1].Error, "not found")
+	}
+}
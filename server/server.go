@@ -33,6 +33,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	// register the gzip compressor so that compressed calls of clients are accepted and answered compressed
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
@@ -448,6 +451,8 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 		grpc_util.ErrorCodeUnaryServerInterceptor(),
 		grpc_util.PanicLoggerUnaryServerInterceptor(a.log),
 	)))
+	// permit clients to keep long running calls alive through intermediaries which drop idle connections
+	sOpts = append(sOpts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{MinTime: common.GRPCKeepAliveEnforcementMinimum}))
 	grpcS := grpc.NewServer(sOpts...)
 	db := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)
 	kubectl := kube.KubectlCmd{}
@@ -457,8 +462,8 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	projectLock := util.NewKeyLock()
 	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, a.Cache, kubectl, db, a.enf, projectLock, a.settingsMgr)
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr)
-	settingsService := settings.NewServer(a.settingsMgr)
-	accountService := account.NewServer(a.sessionMgr, a.settingsMgr)
+	settingsService := settings.NewServer(a.settingsMgr, a, a.enf)
+	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf)
 	certificateService := certificate.NewServer(a.RepoClientset, db, a.enf, a.Cache)
 	versionpkg.RegisterVersionServiceServer(grpcS, &version.Server{})
 	clusterpkg.RegisterClusterServiceServer(grpcS, clusterService)
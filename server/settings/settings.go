@@ -1,23 +1,65 @@
 package settings
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/ghodss/yaml"
 	"golang.org/x/net/context"
 
 	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/util/lua"
+	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
+const (
+	// resourceActionsSourceBuiltin is the source of the resource actions shipped with Argo CD
+	resourceActionsSourceBuiltin = "builtin"
+	// resourceActionsSourceCustomization is the source of the resource actions configured in argocd-cm
+	resourceActionsSourceCustomization = "customization"
+)
+
+// resourceActionRunOptions are the options of resource action runs the application service supports, which older
+// servers would silently ignore. Clients check them before running an action with any of them
+var resourceActionRunOptions = []string{
+	"revision",
+	"runAnnotationKey",
+	"recordedCommand",
+	"fieldManager",
+	"dryRun",
+	"reason",
+	"resourceVersion",
+	"returnTargetState",
+	// the RunResourceActions call, running an action on many resources at once
+	"batch",
+	// actions run on the Application resource itself
+	"applicationActions",
+}
+
+// getSettingsMethod is the only method of the settings service which does not require authentication, since the
+// settings are needed before logging in
+const getSettingsMethod = "/cluster.SettingsService/Get"
+
 // Server provides a Settings service
 type Server struct {
-	mgr *settings.SettingsManager
+	mgr           *settings.SettingsManager
+	authenticator Authenticator
+	enf           *rbac.Enforcer
+}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context) (context.Context, error)
 }
 
 // NewServer returns a new instance of the Settings service
-func NewServer(mgr *settings.SettingsManager) *Server {
+func NewServer(mgr *settings.SettingsManager, authenticator Authenticator, enf *rbac.Enforcer) *Server {
 	return &Server{
-		mgr: mgr,
+		mgr:           mgr,
+		authenticator: authenticator,
+		enf:           enf,
 	}
 }
 
@@ -69,7 +111,8 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 			ChatUrl:  help.ChatURL,
 			ChatText: help.ChatText,
 		},
-		Plugins: plugins,
+		Plugins:                  plugins,
+		ResourceActionRunOptions: resourceActionRunOptions,
 	}
 	if argoCDSettings.DexConfig != "" {
 		var cfg settingspkg.DexConfig
@@ -93,6 +136,58 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 	return &set, nil
 }
 
+// ListResourceActions returns the resource actions shipped with Argo CD along with those configured in the resource
+// customizations, sorted by kind. Requires permission to get all applications
+func (s *Server) ListResourceActions(ctx context.Context, q *settingspkg.ResourceActionsQuery) (*settingspkg.ResourceActionsList, error) {
+	// the actions are defined for the resources of any application
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, "*/*"); err != nil {
+		return nil, err
+	}
+	builtin, err := lua.BuiltinResourceActions()
+	if err != nil {
+		return nil, err
+	}
+	list := &settingspkg.ResourceActionsList{Items: []settingspkg.ResourceActionsEntry{}}
+	for key, actions := range builtin {
+		list.Items = append(list.Items, newResourceActionsEntry(key, resourceActionsSourceBuiltin, actions))
+	}
+	resourceOverrides, err := s.mgr.GetResourceOverrides()
+	if err != nil {
+		return nil, err
+	}
+	for key, override := range resourceOverrides {
+		if override.Actions == "" {
+			continue
+		}
+		actions, err := override.GetActions()
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, newResourceActionsEntry(key, resourceActionsSourceCustomization, actions))
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		a, b := list.Items[i], list.Items[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Source < b.Source
+	})
+	return list, nil
+}
+
+// newResourceActionsEntry returns the entry of the actions defined for the resources of the given resource
+// customization key, i.e. GROUP/KIND, or KIND for the core group
+func newResourceActionsEntry(key string, source string, actions v1alpha1.ResourceActions) settingspkg.ResourceActionsEntry {
+	entry := settingspkg.ResourceActionsEntry{Kind: key, Source: source, Actions: actions}
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		entry.Group, entry.Kind = key[:i], key[i+1:]
+	}
+	return entry
+}
+
 func (s *Server) plugins() ([]*settingspkg.Plugin, error) {
 	in, err := s.mgr.GetConfigManagementPlugins()
 	if err != nil {
@@ -106,7 +201,11 @@ func (s *Server) plugins() ([]*settingspkg.Plugin, error) {
 	return out, nil
 }
 
-// AuthFuncOverride disables authentication for settings service
+// AuthFuncOverride disables authentication for getting the settings. The other methods authenticate the user as
+// any other service does
 func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
-	return ctx, nil
+	if fullMethodName == getSettingsMethod {
+		return ctx, nil
+	}
+	return s.authenticator.Authenticate(ctx)
 }
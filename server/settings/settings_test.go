@@ -0,0 +1,84 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/errors"
+	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
+	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
+
+// fakeAuthenticator rejects any caller without a session
+type fakeAuthenticator struct{}
+
+func (fakeAuthenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	return ctx, status.Error(codes.Unauthenticated, "no session information")
+}
+
+func newTestSettingsServer(ctx context.Context) *Server {
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSecretName,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string][]byte{
+			"server.secretkey": []byte("test"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(ctx, kubeclientset, testNamespace)
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	errors.CheckError(enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	errors.CheckError(enforcer.SetUserPolicy("g, admin, role:admin"))
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return enforcer.Enforce(append([]interface{}{claims.(*jwt.StandardClaims).Subject}, rvals[1:]...)...)
+	})
+	return NewServer(settingsMgr, fakeAuthenticator{}, enforcer)
+}
+
+func TestAuthFuncOverride(t *testing.T) {
+	ctx := context.Background()
+	server := newTestSettingsServer(ctx)
+
+	_, err := server.AuthFuncOverride(ctx, "/cluster.SettingsService/Get")
+	assert.NoError(t, err)
+
+	_, err = server.AuthFuncOverride(ctx, "/cluster.SettingsService/ListResourceActions")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestListResourceActionsRBAC(t *testing.T) {
+	ctx := context.Background()
+	server := newTestSettingsServer(ctx)
+
+	_, err := server.ListResourceActions(context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "alice"}), &settingspkg.ResourceActionsQuery{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	list, err := server.ListResourceActions(context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin"}), &settingspkg.ResourceActionsQuery{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, list.Items)
+}
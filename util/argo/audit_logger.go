@@ -22,6 +22,8 @@ type AuditLogger struct {
 type EventInfo struct {
 	Type   string
 	Reason string
+	// Annotations are set on the event, if any
+	Annotations map[string]string
 }
 
 const (
@@ -31,6 +33,7 @@ const (
 	EventReasonResourceDeleted    = "ResourceDeleted"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonResourceActionRan  = "ResourceActionRan"
 )
 
 func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string) {
@@ -53,7 +56,8 @@ func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersio
 	t := metav1.Time{Time: time.Now()}
 	event := v1.Event{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: fmt.Sprintf("%v.%x", objMeta.Name, t.UnixNano()),
+			Name:        fmt.Sprintf("%v.%x", objMeta.Name, t.UnixNano()),
+			Annotations: info.Annotations,
 		},
 		Source: v1.EventSource{
 			Component: l.component,
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -65,6 +66,10 @@ func AddKubectlFlagsToCmd(cmd *cobra.Command) clientcmd.ClientConfig {
 	return clientcmd.NewInteractiveDeferredLoadingClientConfig(loadingRules, &overrides, os.Stdin)
 }
 
+// PromptOutput is where prompts are written. Commands printing results which may be redirected set it to stderr, so
+// that prompts never end up in the results.
+var PromptOutput io.Writer = os.Stdout
+
 // PromptCredentials is a helper to prompt the user for a username and password (unless already supplied)
 func PromptCredentials(username, password string) (string, string) {
 	return PromptUsername(username), PromptPassword(password)
@@ -79,7 +84,7 @@ func PromptUsername(username string) string {
 func PromptMessage(message, value string) string {
 	for value == "" {
 		reader := bufio.NewReader(os.Stdin)
-		fmt.Print(message + ": ")
+		fmt.Fprint(PromptOutput, message+": ")
 		valueRaw, err := reader.ReadString('\n')
 		errors.CheckError(err)
 		value = strings.TrimSpace(valueRaw)
@@ -90,11 +95,11 @@ func PromptMessage(message, value string) string {
 // PromptPassword prompts the user for a password, without local echo. (unless already supplied)
 func PromptPassword(password string) string {
 	for password == "" {
-		fmt.Print("Password: ")
+		fmt.Fprint(PromptOutput, "Password: ")
 		passwordRaw, err := terminal.ReadPassword(syscall.Stdin)
 		errors.CheckError(err)
 		password = string(passwordRaw)
-		fmt.Print("\n")
+		fmt.Fprint(PromptOutput, "\n")
 	}
 	return password
 }
@@ -103,7 +108,7 @@ func PromptPassword(password string) string {
 // or not they responded in the affirmative or negative.
 func AskToProceed(message string) bool {
 	for {
-		fmt.Print(message)
+		fmt.Fprint(PromptOutput, message)
 		reader := bufio.NewReader(os.Stdin)
 		proceedRaw, err := reader.ReadString('\n')
 		errors.CheckError(err)
@@ -116,6 +121,25 @@ func AskToProceed(message string) bool {
 	}
 }
 
+// PromptChoice prompts the user to choose one of the given options by its number and returns the index of the
+// chosen option
+func PromptChoice(message string, options []string) int {
+	for {
+		fmt.Fprintln(PromptOutput, message)
+		for i, option := range options {
+			fmt.Fprintf(PromptOutput, "  %d) %s\n", i+1, option)
+		}
+		fmt.Fprint(PromptOutput, "Choice: ")
+		reader := bufio.NewReader(os.Stdin)
+		choiceRaw, err := reader.ReadString('\n')
+		errors.CheckError(err)
+		choice, err := strconv.Atoi(strings.TrimSpace(choiceRaw))
+		if err == nil && choice >= 1 && choice <= len(options) {
+			return choice - 1
+		}
+	}
+}
+
 // ReadAndConfirmPassword is a helper to read and confirm a password from stdin
 func ReadAndConfirmPassword() (string, error) {
 	for {
@@ -8,7 +8,8 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 )
 
-func kubeErrToGRPC(err error) error {
+// KubeErrToGRPC replaces a Kubernetes error with its gRPC equivalent, if any
+func KubeErrToGRPC(err error) error {
 	/*
 		Unmapped source Kubernetes API errors as of 2018-04-16:
 		* IsConflict => 409
@@ -62,7 +63,7 @@ func kubeErrToGRPC(err error) error {
 func ErrorCodeUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		resp, err = handler(ctx, req)
-		return resp, kubeErrToGRPC(err)
+		return resp, KubeErrToGRPC(err)
 	}
 }
 
@@ -70,6 +71,6 @@ func ErrorCodeUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 func ErrorCodeStreamServerInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		err := handler(srv, ss)
-		return kubeErrToGRPC(err)
+		return KubeErrToGRPC(err)
 	}
 }
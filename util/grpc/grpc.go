@@ -1,8 +1,13 @@
 package grpc
 
 import (
+	"bufio"
 	"crypto/tls"
+	"encoding/base64"
+	"fmt"
 	"net"
+	"net/http"
+	"net/url"
 	"runtime/debug"
 	"strings"
 	"time"
@@ -62,7 +67,7 @@ func BlockingDial(ctx context.Context, network, address string, creds credential
 		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
-		conn, err := (&net.Dialer{Cancel: ctx.Done()}).Dial(network, address)
+		conn, err := dialProxy(ctx, network, address)
 		if err != nil {
 			writeResult(err)
 			return nil, err
@@ -158,3 +163,76 @@ func WithTimeout(duration time.Duration) grpc.UnaryClientInterceptor {
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// proxyFromEnvironment returns the proxy to use for a request, as configured by the HTTPS_PROXY and NO_PROXY
+// environment variables
+var proxyFromEnvironment = http.ProxyFromEnvironment
+
+// dialProxy dials the address, tunneling the connection through the proxy configured by the environment (if any)
+// using an HTTP CONNECT request. Since BlockingDial uses a custom dialer, grpc's own proxy support does not apply.
+func dialProxy(ctx context.Context, network, address string) (net.Conn, error) {
+	dialer := &net.Dialer{Cancel: ctx.Done()}
+	if network != "tcp" {
+		return dialer.Dial(network, address)
+	}
+	proxyURL, err := proxyFromEnvironment(&http.Request{URL: &url.URL{Scheme: "https", Host: address}})
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL == nil {
+		return dialer.Dial(network, address)
+	}
+	proxyAddr := proxyURL.Host
+	if proxyURL.Port() == "" {
+		if proxyURL.Scheme == "https" {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "443")
+		} else {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "80")
+		}
+	}
+	conn, err := dialer.Dial(network, proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		conn = tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+	}
+	connectReq := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		auth := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		connectReq.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+	if err := connectReq.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, connectReq)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("proxy %s failed to connect to %s: %s", proxyAddr, address, resp.Status)
+	}
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
+
+// bufferedConn is a connection whose reads are served from a buffered reader first, since the reader used to read
+// the proxy response may have buffered data sent by the server
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+// startConnectProxy starts a minimal HTTP CONNECT proxy and returns its address along with a channel receiving the
+// target of each CONNECT request
+func startConnectProxy(t *testing.T) (net.Listener, chan string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	targets := make(chan string, 1)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() { _ = conn.Close() }()
+				req, err := http.ReadRequest(bufio.NewReader(conn))
+				if err != nil || req.Method != http.MethodConnect {
+					return
+				}
+				targets <- req.Host
+				target, err := net.Dial("tcp", req.Host)
+				if err != nil {
+					_, _ = io.WriteString(conn, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+					return
+				}
+				defer func() { _ = target.Close() }()
+				_, _ = io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+				go func() { _, _ = io.Copy(target, conn) }()
+				_, _ = io.Copy(conn, target)
+			}()
+		}
+	}()
+	return ln, targets
+}
+
+func TestDialProxy(t *testing.T) {
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer func() { _ = echo.Close() }()
+	go func() {
+		conn, err := echo.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_, _ = io.Copy(conn, conn)
+	}()
+
+	proxy, targets := startConnectProxy(t)
+	defer func() { _ = proxy.Close() }()
+	defer func(orig func(*http.Request) (*url.URL, error)) { proxyFromEnvironment = orig }(proxyFromEnvironment)
+	proxyFromEnvironment = func(req *http.Request) (*url.URL, error) {
+		return &url.URL{Scheme: "http", Host: proxy.Addr().String()}, nil
+	}
+
+	conn, err := dialProxy(context.Background(), "tcp", echo.Addr().String())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+	assert.Equal(t, echo.Addr().String(), <-targets)
+
+	_, err = io.WriteString(conn, "ping")
+	assert.NoError(t, err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+}
+
+func TestDialProxyRefused(t *testing.T) {
+	proxy, _ := startConnectProxy(t)
+	defer func() { _ = proxy.Close() }()
+	defer func(orig func(*http.Request) (*url.URL, error)) { proxyFromEnvironment = orig }(proxyFromEnvironment)
+	proxyFromEnvironment = func(req *http.Request) (*url.URL, error) {
+		return &url.URL{Scheme: "http", Host: proxy.Addr().String()}, nil
+	}
+
+	// nothing listens on port 1, so the proxy fails to connect
+	_, err := dialProxy(context.Background(), "tcp", "127.0.0.1:1")
+	assert.Error(t, err)
+}
@@ -31,7 +31,7 @@ type Kubectl interface {
 	ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error)
 	DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, forceDelete bool) error
 	GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error)
-	PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte) (*unstructured.Unstructured, error)
+	PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte, fieldManager string, dryRun bool) (*unstructured.Unstructured, error)
 	GetAPIResources(config *rest.Config, resourceFilter ResourceFilter) ([]APIResourceInfo, error)
 	GetServerVersion(config *rest.Config) (string, error)
 	SetOnKubectlRun(onKubectlRun func(command string) (util.Closer, error))
@@ -137,8 +137,10 @@ func (k KubectlCmd) GetResource(config *rest.Config, gvk schema.GroupVersionKind
 	return resourceIf.Get(name, metav1.GetOptions{})
 }
 
-// PatchResource patches resource
-func (k KubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte) (*unstructured.Unstructured, error) {
+// PatchResource patches resource. The changes are recorded in the managed fields of the resource with the given field
+// manager, or with the default of the API server if it is empty. If dryRun is set, the patch is submitted as a server
+// side dry run and the resulting resource is returned without being persisted.
+func (k KubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte, fieldManager string, dryRun bool) (*unstructured.Unstructured, error) {
 	dynamicIf, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return nil, err
@@ -153,7 +155,11 @@ func (k KubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVersionKi
 	}
 	resource := gvk.GroupVersion().WithResource(apiResource.Name)
 	resourceIf := ToResourceInterface(dynamicIf, apiResource, resource, namespace)
-	return resourceIf.Patch(name, patchType, patchBytes, metav1.PatchOptions{})
+	opts := metav1.PatchOptions{FieldManager: fieldManager}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	return resourceIf.Patch(name, patchType, patchBytes, opts)
 }
 
 // DeleteResource deletes resource
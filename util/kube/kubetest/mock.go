@@ -31,7 +31,7 @@ func (k *MockKubectlCmd) GetResource(config *rest.Config, gvk schema.GroupVersio
 	return nil, nil
 }
 
-func (k *MockKubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte) (*unstructured.Unstructured, error) {
+func (k *MockKubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte, fieldManager string, dryRun bool) (*unstructured.Unstructured, error) {
 	return nil, nil
 }
 
@@ -17,6 +17,29 @@ type LocalConfig struct {
 	Contexts       []ContextRef `json:"contexts"`
 	Servers        []Server     `json:"servers"`
 	Users          []User       `json:"users"`
+	// GroupAliases maps short names to API groups, in addition to (or overriding) the built-in aliases
+	GroupAliases map[string]string `json:"group-aliases,omitempty"`
+	// SelectorProfiles are named sets of resource selectors which can be used by resource action commands
+	SelectorProfiles []SelectorProfile `json:"selector-profiles,omitempty"`
+	// LastActionRuns are the most recently saved resource action runs, at most one per application
+	LastActionRuns []ActionRun `json:"last-action-runs,omitempty"`
+}
+
+// ActionRun is a saved invocation of a resource action run
+type ActionRun struct {
+	App    string `json:"app"`
+	Action string `json:"action"`
+	// Flags are the explicitly specified flags of the invocation, e.g. --kind=Deployment
+	Flags []string `json:"flags,omitempty"`
+}
+
+// SelectorProfile is a named set of resource selectors
+type SelectorProfile struct {
+	Name         string `json:"name"`
+	Group        string `json:"group,omitempty"`
+	Kind         string `json:"kind,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	ResourceName string `json:"resource-name,omitempty"`
 }
 
 // ContextRef is a reference to a Server and User for an API client
@@ -207,6 +230,34 @@ func (l *LocalConfig) RemoveToken(serverName string) bool {
 	return false
 }
 
+func (l *LocalConfig) GetSelectorProfile(name string) (*SelectorProfile, error) {
+	for _, p := range l.SelectorProfiles {
+		if p.Name == name {
+			return &p, nil
+		}
+	}
+	return nil, fmt.Errorf("Selector profile '%s' undefined", name)
+}
+
+func (l *LocalConfig) GetLastActionRun(app string) (*ActionRun, error) {
+	for _, r := range l.LastActionRuns {
+		if r.App == app {
+			return &r, nil
+		}
+	}
+	return nil, fmt.Errorf("No saved action run for application '%s'", app)
+}
+
+func (l *LocalConfig) UpsertLastActionRun(run ActionRun) {
+	for i, r := range l.LastActionRuns {
+		if r.App == run.App {
+			l.LastActionRuns[i] = run
+			return
+		}
+	}
+	l.LastActionRuns = append(l.LastActionRuns, run)
+}
+
 func (l *LocalConfig) UpsertContext(context ContextRef) {
 	for i, c := range l.Contexts {
 		if c.Name == context.Name {
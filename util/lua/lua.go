@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/gobuffalo/packr"
@@ -40,6 +42,34 @@ type VM struct {
 	UseOpenLibs bool
 }
 
+// ScriptError is returned if a resource action script fails to run
+type ScriptError struct {
+	// Message is the Lua error message, which is prefixed by the line of the script at which the error was raised
+	Message string
+	// StackTrace holds the entries of the Lua stack traceback at the point of the error, if any
+	StackTrace []string
+}
+
+func (e *ScriptError) Error() string {
+	return e.Message
+}
+
+// newScriptError converts the error returned by the Lua state into a ScriptError
+func newScriptError(err error) error {
+	apiErr, ok := err.(*lua.ApiError)
+	if !ok {
+		return err
+	}
+	scriptErr := &ScriptError{Message: strings.TrimSpace(apiErr.Object.String())}
+	for _, line := range strings.Split(apiErr.StackTrace, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && line != "stack traceback:" {
+			scriptErr.StackTrace = append(scriptErr.StackTrace, line)
+		}
+	}
+	return scriptErr
+}
+
 func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState, error) {
 	l := lua.NewState(lua.Options{
 		SkipOpenLibs: !vm.UseOpenLibs,
@@ -114,10 +144,12 @@ func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, error) {
 	return vm.getPredefinedLuaScripts(key, healthScriptFile)
 }
 
+// ExecuteResourceAction runs the action script against obj and returns the resulting object. A *ScriptError is returned
+// if the script raises an error.
 func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) (*unstructured.Unstructured, error) {
 	l, err := vm.runLua(obj, script)
 	if err != nil {
-		return nil, err
+		return nil, newScriptError(err)
 	}
 	returnValue := l.Get(-1)
 	if returnValue.Type() == lua.LTTable {
@@ -230,6 +262,10 @@ func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, scri
 			}
 			availableActions = append(availableActions, resourceAction)
 		}
+		// the actions are decoded from a map, so they are sorted to be listed in a stable order
+		sort.Slice(availableActions, func(i, j int) bool {
+			return availableActions[i].Name < availableActions[j].Name
+		})
 		return availableActions, err
 	}
 
@@ -308,6 +344,41 @@ func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string
 	}, nil
 }
 
+// BuiltinResourceActions returns the resource actions shipped with Argo CD by the key of the kind of resources they
+// are defined for, i.e. GROUP/KIND, or KIND for the core group
+func BuiltinResourceActions() (map[string]appv1.ResourceActions, error) {
+	files := box.List()
+	sort.Strings(files)
+	builtin := make(map[string]appv1.ResourceActions)
+	for _, file := range files {
+		file = filepath.ToSlash(file)
+		i := strings.Index(file, "/actions/")
+		if i < 0 {
+			continue
+		}
+		key, rest := file[:i], file[i+len("/actions/"):]
+		parts := strings.Split(rest, "/")
+		var err error
+		actions := builtin[key]
+		switch {
+		case len(parts) == 1 && parts[0] == actionDiscoveryScriptFile:
+			actions.ActionDiscoveryLua, err = box.MustString(file)
+		case len(parts) == 2 && parts[1] == actionScriptFile:
+			var script string
+			script, err = box.MustString(file)
+			actions.Definitions = append(actions.Definitions, appv1.ResourceActionDefinition{Name: parts[0], ActionLua: script})
+		default:
+			// tests and their data
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		builtin[key] = actions
+	}
+	return builtin, nil
+}
+
 func getConfigMapKey(obj *unstructured.Unstructured) string {
 	gvk := obj.GroupVersionKind()
 	if gvk.Group == "" {
@@ -149,6 +149,27 @@ func TestGetResourceActionPredefined(t *testing.T) {
 	assert.NotEmpty(t, action)
 }
 
+func TestBuiltinResourceActions(t *testing.T) {
+	builtin, err := BuiltinResourceActions()
+	assert.NoError(t, err)
+	rollout, ok := builtin["argoproj.io/Rollout"]
+	if assert.True(t, ok) {
+		assert.NotEmpty(t, rollout.ActionDiscoveryLua)
+		if assert.Len(t, rollout.Definitions, 2) {
+			assert.Equal(t, "pause", rollout.Definitions[0].Name)
+			assert.Equal(t, "resume", rollout.Definitions[1].Name)
+			assert.NotEmpty(t, rollout.Definitions[1].ActionLua)
+		}
+	}
+	deployment, ok := builtin["apps/Deployment"]
+	if assert.True(t, ok) && assert.Len(t, deployment.Definitions, 1) {
+		assert.Equal(t, "restart", deployment.Definitions[0].Name)
+	}
+	// kinds with only a health check have no actions
+	_, ok = builtin["certmanager.k8s.io/Certificate"]
+	assert.False(t, ok)
+}
+
 func TestGetResourceActionNoPredefined(t *testing.T) {
 	testObj := StrToUnstructured(objWithNoScriptJSON)
 	vm := VM{}
@@ -217,7 +238,7 @@ const validDiscoveryLua = `
 scaleParams = { {name = "replicas", type = "number"} }
 scale = {name = 'scale', params = scaleParams}
 
-resume = {name = 'resume'}
+resume = {name = 'resume', conditions = {'Paused'}}
 
 test = {}
 a = {scale = scale, resume = resume, test = test}
@@ -232,7 +253,8 @@ func TestExecuteResourceActionDiscovery(t *testing.T) {
 	assert.Nil(t, err)
 	expectedActions := []appv1.ResourceAction{
 		{
-			Name: "resume",
+			Name:       "resume",
+			Conditions: []string{"Paused"},
 		}, {
 			Name: "scale",
 			Params: []appv1.ResourceActionParam{{
@@ -308,6 +330,22 @@ func TestExecuteResourceActionNonTableReturn(t *testing.T) {
 	assert.Errorf(t, err, incorrectReturnType, "table", "number")
 }
 
+const runtimeErrorActionLua = `local replicas = obj.spec.replicas
+obj.spec.replicas = replicas + 1
+return obj
+`
+
+func TestExecuteResourceActionRuntimeError(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	_, err := vm.ExecuteResourceAction(testObj, runtimeErrorActionLua)
+	if assert.IsType(t, &ScriptError{}, err) {
+		scriptErr := err.(*ScriptError)
+		assert.Equal(t, "<string>:1: attempt to index a non-table object(nil)", scriptErr.Message)
+		assert.Contains(t, scriptErr.StackTrace, "<string>:1: in main chunk")
+	}
+}
+
 const invalidTableReturn = `newObj = {}
 newObj["test"] = "test"
 return newObj
@@ -187,6 +187,8 @@ const (
 	kustomizeBuildOptions = "kustomize.buildOptions"
 	// anonymousUserEnabledKey is the key which enables or disables anonymous user
 	anonymousUserEnabledKey = "users.anonymous.enabled"
+	// actionsRequireReasonKey is the key which requires a reason to be given for running resource actions
+	actionsRequireReasonKey = "resource.actions.requireReason"
 )
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -328,6 +330,15 @@ func (mgr *SettingsManager) GetKustomizeBuildOptions() (string, error) {
 	return argoCDCM.Data[kustomizeBuildOptions], nil
 }
 
+// GetActionsRequireReason returns whether a reason must be given for running resource actions
+func (mgr *SettingsManager) GetActionsRequireReason() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	return argoCDCM.Data[actionsRequireReasonKey] == "true", nil
+}
+
 // DEPRECATED. Helm repository credentials are now managed using RepoCredentials
 func (mgr *SettingsManager) GetHelmRepositories() ([]HelmRepoCredentials, error) {
 	argoCDCM, err := mgr.getConfigMap()
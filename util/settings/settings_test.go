@@ -135,6 +135,18 @@ func TestSettingsManager_GetKustomizeBuildOptions(t *testing.T) {
 	})
 }
 
+func TestGetActionsRequireReason(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	required, err := settingsManager.GetActionsRequireReason()
+	assert.NoError(t, err)
+	assert.False(t, required)
+
+	_, settingsManager = fixtures(map[string]string{"resource.actions.requireReason": "true"})
+	required, err = settingsManager.GetActionsRequireReason()
+	assert.NoError(t, err)
+	assert.True(t, required)
+}
+
 func TestGetGoogleAnalytics(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		"ga.trackingid": "123",